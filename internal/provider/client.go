@@ -3,11 +3,17 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 	"sync"
 
 	"github.com/solusio/solus-go-sdk"
+	"gopkg.in/guregu/null.v4"
 )
 
 type client struct {
@@ -37,3 +43,505 @@ func (c *client) CurrentUser(ctx context.Context) (solus.User, error) {
 	})
 	return c.account.data, c.account.err
 }
+
+// request makes a raw API request.
+// It should be used only for endpoints and properties which aren't supported
+// by the SDK yet. Returned errors are compatible with SDK errors, so they can
+// be processed by `normalizeAPIError` and `solus.IsNotFound`.
+func (c *client) request(ctx context.Context, method, path string, data, resp interface{}) error {
+	var body io.Reader
+	if data != nil {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	u, err := c.BaseURL.Parse(path)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return err
+	}
+
+	for k, vv := range c.Headers {
+		for _, v := range vv {
+			req.Header.Add(k, v)
+		}
+	}
+	req.Header.Set("User-Agent", c.UserAgent)
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close() //nolint:errcheck // Nothing to do with this error.
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		httpErr := solus.HTTPError{
+			Method:   method,
+			Path:     path,
+			HTTPCode: res.StatusCode,
+		}
+		if err := json.Unmarshal(b, &httpErr); err != nil {
+			httpErr.Message = string(b)
+		}
+		return httpErr
+	}
+
+	if resp == nil || len(b) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(b, resp); err != nil {
+		return fmt.Errorf("failed to decode %q: %w", b, err)
+	}
+	return nil
+}
+
+// computeResourceUpdateRequest extends the SDK request to be able to remove all
+// locations and IP blocks, since the SDK omits them when they are empty.
+type computeResourceUpdateRequest struct {
+	solus.ComputerResourceCreateRequest
+
+	IPBlocks  []int `json:"ip_blocks"`
+	Locations []int `json:"locations"`
+}
+
+// ComputeResourcePatch patches specified compute resource.
+func (c *client) ComputeResourcePatch(
+	ctx context.Context,
+	id int,
+	data computeResourceUpdateRequest,
+) (solus.ComputeResource, error) {
+	var resp struct {
+		Data solus.ComputeResource `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodPatch, fmt.Sprintf("compute_resources/%d", id), data, &resp)
+}
+
+// BackupNodeGet gets specified backup node.
+func (c *client) BackupNodeGet(ctx context.Context, id int) (solus.BackupNode, error) {
+	var resp struct {
+		Data solus.BackupNode `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodGet, fmt.Sprintf("backup_nodes/%d", id), nil, &resp)
+}
+
+// backupNodeUpdateRequest extends the SDK request to be able to remove all
+// compute resources, since the SDK omits them when they are empty.
+type backupNodeUpdateRequest struct {
+	solus.BackupNodeRequest
+
+	ComputeResources []int `json:"compute_resources"`
+}
+
+// BackupNodeUpdate updates specified backup node.
+func (c *client) BackupNodeUpdate(
+	ctx context.Context,
+	id int,
+	data backupNodeUpdateRequest,
+) (solus.BackupNode, error) {
+	var resp struct {
+		Data solus.BackupNode `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodPut, fmt.Sprintf("backup_nodes/%d", id), data, &resp)
+}
+
+// computeResourceStorageCreateRequest extends the SDK request with `mount` and
+// `credentials` properties. They are required for NFS storages, but the SDK
+// doesn't support them yet, so `ComputeResourcesService.StorageCreate` can't be
+// used.
+type computeResourceStorageCreateRequest struct {
+	solus.ComputeResourceStorageCreateRequest
+
+	Mount       string                 `json:"mount,omitempty"`
+	Credentials map[string]interface{} `json:"credentials,omitempty"`
+}
+
+// ComputeResourceStorageCreate creates a new storage for the specified compute
+// resource.
+func (c *client) ComputeResourceStorageCreate(
+	ctx context.Context,
+	id int,
+	data computeResourceStorageCreateRequest,
+) (solus.Storage, error) {
+	var resp struct {
+		Data solus.Storage `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodPost, fmt.Sprintf("compute_resources/%d/storages", id), data, &resp)
+}
+
+// user extends the SDK user with its language, which isn't supported by the
+// SDK yet.
+type user struct {
+	solus.User
+
+	Language *userLanguage `json:"language"`
+}
+
+type userLanguage struct {
+	ID int `json:"id"`
+}
+
+// UserGet gets specified user.
+func (c *client) UserGet(ctx context.Context, id int) (user, error) {
+	var resp struct {
+		Data user `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodGet, fmt.Sprintf("users/%d", id), nil, &resp)
+}
+
+// userUpdateRequest extends the SDK request to be able to remove all roles,
+// since the SDK omits `roles` property when it's empty.
+type userUpdateRequest struct {
+	solus.UserUpdateRequest
+
+	Roles []int `json:"roles"`
+}
+
+// UserUpdate updates specified user.
+func (c *client) UserUpdate(ctx context.Context, id int, data userUpdateRequest) (user, error) {
+	var resp struct {
+		Data user `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodPut, fmt.Sprintf("users/%d", id), data, &resp)
+}
+
+// role extends the SDK role with properties which aren't supported by the SDK
+// yet.
+type role struct {
+	solus.Role
+
+	Permissions []solus.Permission `json:"permissions"`
+}
+
+// RoleGet gets specified role with its permissions.
+func (c *client) RoleGet(ctx context.Context, id int) (role, error) {
+	var resp struct {
+		Data role `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodGet, fmt.Sprintf("roles/%d", id), nil, &resp)
+}
+
+// roleUpdateRequest extends the SDK request to be able to remove all
+// permissions, since the SDK omits `permissions` property when it's empty.
+type roleUpdateRequest struct {
+	solus.RoleCreateRequest
+
+	Permissions []int `json:"permissions"`
+}
+
+// RoleUpdate updates specified role.
+func (c *client) RoleUpdate(ctx context.Context, id int, data roleUpdateRequest) (role, error) {
+	var resp struct {
+		Data role `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodPut, fmt.Sprintf("roles/%d", id), data, &resp)
+}
+
+// RoleDelete deletes specified role.
+func (c *client) RoleDelete(ctx context.Context, id int) error {
+	return c.request(ctx, http.MethodDelete, fmt.Sprintf("roles/%d", id), nil, nil)
+}
+
+// applicationRequest extends the SDK request with properties which aren't
+// supported by the SDK yet.
+type applicationRequest struct {
+	solus.ApplicationCreateRequest
+
+	// IconID overrides the SDK property to be able to send null instead of
+	// zero.
+	IconID    null.Int `json:"icon_id"`
+	IsDefault bool     `json:"is_default"`
+}
+
+// ApplicationCreate creates new application.
+func (c *client) ApplicationCreate(ctx context.Context, data applicationRequest) (solus.Application, error) {
+	var resp struct {
+		Data solus.Application `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodPost, "applications", data, &resp)
+}
+
+// ApplicationGet gets specified application.
+func (c *client) ApplicationGet(ctx context.Context, id int) (solus.Application, error) {
+	var resp struct {
+		Data solus.Application `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodGet, fmt.Sprintf("applications/%d", id), nil, &resp)
+}
+
+// ApplicationUpdate updates specified application.
+func (c *client) ApplicationUpdate(ctx context.Context, id int, data applicationRequest) (solus.Application, error) {
+	var resp struct {
+		Data solus.Application `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodPut, fmt.Sprintf("applications/%d", id), data, &resp)
+}
+
+// ApplicationDelete deletes specified application.
+func (c *client) ApplicationDelete(ctx context.Context, id int) error {
+	return c.request(ctx, http.MethodDelete, fmt.Sprintf("applications/%d", id), nil, nil)
+}
+
+// settingsUpdateRequest extends the SDK request to be able to send false
+// values of boolean properties and empty lists, which are omitted by the SDK.
+type settingsUpdateRequest struct {
+	solus.SettingsUpdateRequest
+
+	DNS                   *settingsDNS                   `json:"dns,omitempty"`
+	Mail                  *settingsMail                  `json:"mail,omitempty"`
+	NetworkRules          *settingsNetworkRules          `json:"network_rules,omitempty"`
+	NonExistentVMSRemover *settingsNonExistentVMSRemover `json:"non_existent_vms_remover,omitempty"`
+	Update                *settingsUpdate                `json:"update,omitempty"`
+
+	// Notifications is a map to be able to patch only specific templates.
+	Notifications map[string]settingsNotificationsTemplate `json:"notifications,omitempty"`
+}
+
+// settingsDNS declares all DNS properties without `omitempty`, so templates
+// and the driver can be cleared.
+type settingsDNS struct {
+	Type                       string             `json:"type"`
+	ServerHostnameTemplate     string             `json:"server_hostname_template"`
+	RegisterFQDNOnServerCreate bool               `json:"register_fqdn_on_server_create"`
+	ReverseDNSDomainTemplate   string             `json:"reverse_dns_domain_template"`
+	Drivers                    settingsDNSDrivers `json:"drivers"`
+}
+
+type settingsDNSDrivers struct {
+	PowerDNS settingsDNSDriversPowerDNS `json:"power_dns"`
+}
+
+type settingsDNSDriversPowerDNS struct {
+	Host   string `json:"host"`
+	APIKey string `json:"api_key"`
+}
+
+type settingsMail struct {
+	Host       string `json:"host,omitempty"`
+	Username   string `json:"username,omitempty"`
+	Password   string `json:"password,omitempty"`
+	FromEmail  string `json:"from_email,omitempty"`
+	FromName   string `json:"from_name,omitempty"`
+	Encryption bool   `json:"encryption"`
+}
+
+type settingsNetworkRules struct {
+	ARP       bool `json:"arp"`
+	DHCP      bool `json:"dhcp"`
+	CloudInit bool `json:"cloud_init"`
+	SMTP      bool `json:"smtp"`
+	ICMP      bool `json:"icmp"`
+	ICMPReply bool `json:"icmp_reply"`
+}
+
+type settingsNonExistentVMSRemover struct {
+	Enabled  bool `json:"enabled"`
+	Interval int  `json:"interval,omitempty"`
+}
+
+type settingsUpdate struct {
+	solus.SettingsUpdate
+
+	ScheduledDays []int `json:"scheduled_days"`
+}
+
+type settingsNotificationsTemplate struct {
+	Enabled          bool              `json:"enabled"`
+	SubjectTemplates map[string]string `json:"subject_templates,omitempty"`
+	BodyTemplated    map[string]string `json:"body_templated,omitempty"`
+}
+
+// SettingsPatch patches settings.
+func (c *client) SettingsPatch(ctx context.Context, data settingsUpdateRequest) (solus.Settings, error) {
+	var resp struct {
+		Data solus.Settings `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodPatch, "settings", data, &resp)
+}
+
+// LicenseGet gets current license.
+func (c *client) LicenseGet(ctx context.Context) (solus.License, error) {
+	var resp struct {
+		Data solus.License `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodGet, "license", nil, &resp)
+}
+
+// virtualServer extends the SDK virtual server with the compute resource it's
+// placed on.
+type virtualServer struct {
+	solus.VirtualServer
+
+	ComputeResource *virtualServerComputeResource `json:"compute_resource"`
+}
+
+type virtualServerComputeResource struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// VirtualServerGet gets specified virtual server.
+func (c *client) VirtualServerGet(ctx context.Context, id int) (virtualServer, error) {
+	var resp struct {
+		Data virtualServer `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodGet, fmt.Sprintf("servers/%d", id), nil, &resp)
+}
+
+// virtualServerUpdateRequest extends the SDK request to be able to disable
+// backups and to clear FQDNs, since the SDK omits `enabled` property when it's
+// false and `fqdns` property when it's empty.
+type virtualServerUpdateRequest struct {
+	solus.VirtualServerUpdateRequest
+
+	FQDNs          *[]string                    `json:"fqdns,omitempty"`
+	BackupSettings *virtualServerBackupSettings `json:"backup_settings,omitempty"`
+}
+
+type virtualServerBackupSettings struct {
+	solus.VirtualServerBackupSettings
+
+	Enabled bool `json:"enabled"`
+}
+
+// VirtualServerPatch patches specified virtual server.
+func (c *client) VirtualServerPatch(
+	ctx context.Context,
+	id int,
+	data virtualServerUpdateRequest,
+) (solus.VirtualServer, error) {
+	var resp struct {
+		Data solus.VirtualServer `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodPatch, fmt.Sprintf("servers/%d", id), data, &resp)
+}
+
+// VirtualServerChangePassword changes root password of specified virtual
+// server. Returns the password change task.
+func (c *client) VirtualServerChangePassword(ctx context.Context, id int, password string) (solus.Task, error) {
+	data := struct {
+		Password string `json:"password"`
+	}{
+		Password: password,
+	}
+
+	var resp struct {
+		Data solus.Task `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodPost, fmt.Sprintf("servers/%d/change_password", id), data, &resp)
+}
+
+// task extends the SDK task with the virtual server it's performed for.
+type task struct {
+	solus.Task
+
+	ComputeResourceVMID int `json:"compute_resource_vm_id"`
+}
+
+// serversMigration extends the SDK servers migration to be able to match its
+// tasks with virtual servers.
+type serversMigration struct {
+	solus.ServersMigration
+
+	Children []task `json:"children"`
+}
+
+// ServersMigrationCreate creates a new migration of the specified servers.
+func (c *client) ServersMigrationCreate(
+	ctx context.Context,
+	data solus.ServersMigrationRequest,
+) (serversMigration, error) {
+	var resp struct {
+		Data serversMigration `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodPost, "servers_migrations", data, &resp)
+}
+
+// ipAddress extends the SDK IP address with its reverse DNS and the virtual
+// server it's attached to.
+type ipAddress struct {
+	solus.IPBlockIPAddress
+
+	ReverseDNS *reverseDNSRecord `json:"reverse_dns"`
+	Server     *ipAddressServer  `json:"server"`
+}
+
+type reverseDNSRecord struct {
+	ID     int    `json:"id"`
+	IP     string `json:"ip"`
+	Domain string `json:"domain"`
+}
+
+type ipAddressServer struct {
+	ID int `json:"id"`
+}
+
+// IPAddressGet gets specified IP address.
+func (c *client) IPAddressGet(ctx context.Context, id int) (ipAddress, error) {
+	var resp struct {
+		Data ipAddress `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodGet, fmt.Sprintf("ips/%d", id), nil, &resp)
+}
+
+// VirtualServerIPAttach attaches specified IP address to the virtual server as
+// an additional IP. Returns the attach task.
+func (c *client) VirtualServerIPAttach(ctx context.Context, serverID, ipID int) (solus.Task, error) {
+	return c.virtualServerAdditionalIPs(ctx, http.MethodPost, serverID, ipID)
+}
+
+// VirtualServerIPDetach detaches specified additional IP address from the
+// virtual server. Returns the detach task.
+func (c *client) VirtualServerIPDetach(ctx context.Context, serverID, ipID int) (solus.Task, error) {
+	return c.virtualServerAdditionalIPs(ctx, http.MethodDelete, serverID, ipID)
+}
+
+func (c *client) virtualServerAdditionalIPs(
+	ctx context.Context,
+	method string,
+	serverID, ipID int,
+) (solus.Task, error) {
+	data := struct {
+		IPs []int `json:"ips"`
+	}{
+		IPs: []int{ipID},
+	}
+
+	var resp struct {
+		Data solus.Task `json:"data"`
+	}
+	return resp.Data, c.request(ctx, method, fmt.Sprintf("servers/%d/additional_ips", serverID), data, &resp)
+}
+
+// ReverseDNSRecordSet sets reverse DNS record for the specified IP address.
+// Returns the record register task.
+func (c *client) ReverseDNSRecordSet(ctx context.Context, ipID int, domain string) (solus.Task, error) {
+	data := struct {
+		Domain string `json:"domain"`
+	}{
+		Domain: domain,
+	}
+
+	var resp struct {
+		Data solus.Task `json:"data"`
+	}
+	return resp.Data, c.request(ctx, http.MethodPut, fmt.Sprintf("ips/%d/reverse_dns", ipID), data, &resp)
+}
+
+// ReverseDNSRecordDelete deletes reverse DNS record of the specified IP
+// address.
+func (c *client) ReverseDNSRecordDelete(ctx context.Context, ipID int) error {
+	return c.request(ctx, http.MethodDelete, fmt.Sprintf("ips/%d/reverse_dns", ipID), nil, nil)
+}
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/solusio/solus-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient returns a client for the test server with the specified
+// handler.
+func newTestClient(t *testing.T, h http.HandlerFunc) *client {
+	s := httptest.NewServer(h)
+	t.Cleanup(s.Close)
+
+	u, err := url.Parse(s.URL + "/api/v1/")
+	require.NoError(t, err)
+
+	c, err := newClient(u, solus.APITokenAuthenticator{Token: "token"})
+	require.NoError(t, err)
+	return c
+}
+
+// assertRequest asserts method, path and JSON body of the request. Empty
+// body means there should be no body at all.
+func assertRequest(t *testing.T, r *http.Request, method, path, body string) {
+	assert.Equal(t, method, r.Method)
+	assert.Equal(t, path, r.URL.Path)
+
+	b, err := io.ReadAll(r.Body)
+	require.NoError(t, err)
+
+	if body == "" {
+		assert.Empty(t, b)
+		return
+	}
+	assert.JSONEq(t, body, string(b))
+}
+
+func TestClient_request(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/api/v1/foo/1", r.URL.Path)
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+			b, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"name":"bar"}`, string(b))
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"data":{"id":42}}`))
+		})
+
+		var resp struct {
+			Data struct {
+				ID int `json:"id"`
+			} `json:"data"`
+		}
+		err := c.request(context.Background(), http.MethodPost, "foo/1", map[string]string{"name": "bar"}, &resp)
+		require.NoError(t, err)
+		assert.Equal(t, 42, resp.Data.ID)
+	})
+
+	t.Run("no content", func(t *testing.T) {
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		err := c.request(context.Background(), http.MethodDelete, "foo/1", nil, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"not found"}`))
+		})
+
+		err := c.request(context.Background(), http.MethodGet, "foo/1", nil, nil)
+		assert.True(t, solus.IsNotFound(err))
+		assert.ErrorIs(t, normalizeAPIError(err), errResourceNotFound)
+	})
+
+	t.Run("unprocessable entity", func(t *testing.T) {
+		c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"errors":{"name":["required"]}}`))
+		})
+
+		err := c.request(context.Background(), http.MethodGet, "foo/1", nil, nil)
+		assert.EqualError(t, normalizeAPIError(err), "bad request: name: required")
+	})
+}
+
+func TestClient_ComputeResourcePatch(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(
+			t,
+			r,
+			http.MethodPatch,
+			"/api/v1/compute_resources/1",
+			`{"name":"foo","ip_blocks":[],"locations":[]}`,
+		)
+
+		_, _ = w.Write([]byte(`{"data":{"id":1}}`))
+	})
+
+	res, err := c.ComputeResourcePatch(context.Background(), 1, computeResourceUpdateRequest{
+		ComputerResourceCreateRequest: solus.ComputerResourceCreateRequest{
+			Name: "foo",
+		},
+		IPBlocks:  []int{},
+		Locations: []int{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.ID)
+}
+
+func TestClient_BackupNodeUpdate(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(
+			t,
+			r,
+			http.MethodPut,
+			"/api/v1/backup_nodes/1",
+			`{"name":"foo","type":"hetzner_storage_box","compute_resources":[]}`,
+		)
+
+		_, _ = w.Write([]byte(`{"data":{"id":1}}`))
+	})
+
+	res, err := c.BackupNodeUpdate(context.Background(), 1, backupNodeUpdateRequest{
+		BackupNodeRequest: solus.BackupNodeRequest{
+			Name: "foo",
+			Type: solus.BackupNodeTypeHetznerStorageBox,
+		},
+		ComputeResources: []int{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.ID)
+}
+
+func Test_applicationRequest(t *testing.T) {
+	b, err := json.Marshal(applicationRequest{
+		ApplicationCreateRequest: solus.ApplicationCreateRequest{
+			Name:   "foo",
+			IconID: 42,
+		},
+		IsDefault: true,
+	})
+	require.NoError(t, err)
+
+	var actual map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &actual))
+	assert.Nil(t, actual["icon_id"])
+	assert.Equal(t, "foo", actual["name"])
+	assert.Equal(t, true, actual["is_default"])
+}
+
+func Test_userUpdateRequest(t *testing.T) {
+	b, err := json.Marshal(userUpdateRequest{
+		UserUpdateRequest: solus.UserUpdateRequest{
+			Status: "active",
+		},
+		Roles: []int{},
+	})
+	require.NoError(t, err)
+
+	var actual map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &actual))
+	assert.Equal(t, "active", actual["status"])
+	assert.Equal(t, []interface{}{}, actual["roles"])
+}
+
+func TestClient_RoleUpdate(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(t, r, http.MethodPut, "/api/v1/roles/1", `{"name":"foo","permissions":[]}`)
+
+		_, _ = w.Write([]byte(`{"data":{"id":1,"name":"foo","permissions":[]}}`))
+	})
+
+	res, err := c.RoleUpdate(context.Background(), 1, roleUpdateRequest{
+		RoleCreateRequest: solus.RoleCreateRequest{
+			Name: "foo",
+		},
+		Permissions: []int{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.ID)
+}
+
+func Test_virtualServerUpdateRequest(t *testing.T) {
+	fqdns := []string{}
+
+	b, err := json.Marshal(virtualServerUpdateRequest{
+		VirtualServerUpdateRequest: solus.VirtualServerUpdateRequest{
+			Name: "foo",
+		},
+		FQDNs: &fqdns,
+		BackupSettings: &virtualServerBackupSettings{
+			Enabled: false,
+		},
+	})
+	require.NoError(t, err)
+
+	var actual map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &actual))
+	assert.Equal(t, "foo", actual["name"])
+	assert.Equal(t, []interface{}{}, actual["fqdns"])
+	assert.Equal(t, false, actual["backup_settings"].(map[string]interface{})["enabled"])
+}
+
+func TestClient_VirtualServerChangePassword(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(t, r, http.MethodPost, "/api/v1/servers/1/change_password", `{"password":"secret"}`)
+
+		_, _ = w.Write([]byte(`{"data":{"id":42,"action":"vm-change-password"}}`))
+	})
+
+	task, err := c.VirtualServerChangePassword(context.Background(), 1, "secret")
+	require.NoError(t, err)
+	assert.Equal(t, 42, task.ID)
+}
+
+func TestClient_VirtualServerGet(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(t, r, http.MethodGet, "/api/v1/servers/1", "")
+
+		_, _ = w.Write([]byte(`{"data":{"id":1,"name":"foo","compute_resource":{"id":2,"name":"bar"}}}`))
+	})
+
+	vs, err := c.VirtualServerGet(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, vs.ID)
+	assert.Equal(t, "foo", vs.Name)
+	require.NotNil(t, vs.ComputeResource)
+	assert.Equal(t, 2, vs.ComputeResource.ID)
+}
+
+func TestClient_ServersMigrationCreate(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(t, r, http.MethodPost, "/api/v1/servers_migrations", `{
+			"is_live": false,
+			"preserve_ips": true,
+			"destination_compute_resource_id": 2,
+			"servers": [1]
+		}`)
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{
+			"id": 3,
+			"task": {"id": 4},
+			"children": [{"id": 5, "compute_resource_vm_id": 1}]
+		}}`))
+	})
+
+	m, err := c.ServersMigrationCreate(context.Background(), solus.ServersMigrationRequest{
+		PreserveIPs:                  true,
+		DestinationComputeResourceID: 2,
+		Servers:                      []int{1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, m.ID)
+	assert.Equal(t, 4, m.Task.ID)
+	require.Len(t, m.Children, 1)
+	assert.Equal(t, 5, m.Children[0].ID)
+	assert.Equal(t, 1, m.Children[0].ComputeResourceVMID)
+}
+
+func TestClient_IPAddressGet(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(t, r, http.MethodGet, "/api/v1/ips/1", "")
+
+		_, _ = w.Write([]byte(`{"data":{
+			"id": 1,
+			"ip": "192.0.2.1",
+			"ip_block": {"id": 2},
+			"reverse_dns": {"id": 3, "ip": "192.0.2.1", "domain": "vs.example.com"},
+			"server": {"id": 4}
+		}}`))
+	})
+
+	ip, err := c.IPAddressGet(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, ip.ID)
+	assert.Equal(t, "192.0.2.1", ip.IP)
+	assert.Equal(t, 2, ip.IPBlock.ID)
+	require.NotNil(t, ip.ReverseDNS)
+	assert.Equal(t, "vs.example.com", ip.ReverseDNS.Domain)
+	require.NotNil(t, ip.Server)
+	assert.Equal(t, 4, ip.Server.ID)
+}
+
+func TestClient_VirtualServerIPAttach(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(t, r, http.MethodPost, "/api/v1/servers/1/additional_ips", `{"ips":[2]}`)
+
+		_, _ = w.Write([]byte(`{"data":{"id":42}}`))
+	})
+
+	task, err := c.VirtualServerIPAttach(context.Background(), 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 42, task.ID)
+}
+
+func TestClient_VirtualServerIPDetach(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(t, r, http.MethodDelete, "/api/v1/servers/1/additional_ips", `{"ips":[2]}`)
+
+		_, _ = w.Write([]byte(`{"data":{"id":42}}`))
+	})
+
+	task, err := c.VirtualServerIPDetach(context.Background(), 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 42, task.ID)
+}
+
+func TestClient_ReverseDNSRecordSet(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(t, r, http.MethodPut, "/api/v1/ips/1/reverse_dns", `{"domain":"vs.example.com"}`)
+
+		_, _ = w.Write([]byte(`{"data":{"id":42}}`))
+	})
+
+	task, err := c.ReverseDNSRecordSet(context.Background(), 1, "vs.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, 42, task.ID)
+}
+
+func TestClient_ReverseDNSRecordDelete(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(t, r, http.MethodDelete, "/api/v1/ips/1/reverse_dns", "")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	require.NoError(t, c.ReverseDNSRecordDelete(context.Background(), 1))
+}
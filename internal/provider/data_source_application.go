@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+func dataSourceApplication() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: adoptRead("Application", dataSourceApplicationRead),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "ID of the Application",
+				ValidateFunc: validation.IntAtLeast(1),
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Name of the Application",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"json_schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceApplicationRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	var (
+		res solus.Application
+		err error
+	)
+
+	id, hasID := d.GetOk("id")
+	name, hasName := d.GetOk("name")
+
+	switch {
+	case hasID:
+		res, err = client.ApplicationGet(ctx, id.(int))
+		err = normalizeAPIError(err)
+
+	case hasName:
+		res, err = dataSourceApplicationByName(ctx, client, name.(string))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return newSchemaChainSetter(d).
+		SetID(res.ID).
+		Set("name", res.Name).
+		Set("json_schema", res.JSONSchema).
+		Error()
+}
+
+// dataSourceApplicationByName iterates over all applications since the API
+// doesn't support filtering them by name.
+func dataSourceApplicationByName(ctx context.Context, client *client, name string) (solus.Application, error) {
+	res, err := client.Applications.List(ctx)
+	if err != nil {
+		return solus.Application{}, normalizeAPIError(err)
+	}
+
+	var found []solus.Application
+	for {
+		for _, a := range res.Data {
+			if a.Name == name {
+				found = append(found, a)
+			}
+		}
+
+		if !res.Next(ctx) {
+			break
+		}
+	}
+
+	if err := res.Err(); err != nil {
+		return solus.Application{}, normalizeAPIError(err)
+	}
+
+	if len(found) == 1 {
+		return found[0], nil
+	}
+
+	err = errResourceNotFound
+	if len(found) > 1 {
+		err = errTooManyResults
+	}
+	return solus.Application{}, err
+}
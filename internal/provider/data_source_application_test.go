@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceApplication(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_application." + name
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					`
+resource "solus_application" "%[1]s" {
+	name = "%[1]s"
+	url = "https://images.example.com/%[1]s.qcow2"
+	cloud_init_version = "v2"
+}
+
+data "solus_application" "%[1]s_by_id" {
+	id = solus_application.%[1]s.id
+}
+
+data "solus_application" "%[1]s_by_name" {
+	name = solus_application.%[1]s.name
+}
+`,
+					name,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data."+resName+"_by_id", "id"),
+					resource.TestCheckResourceAttr("data."+resName+"_by_id", "name", name),
+
+					resource.TestCheckResourceAttrSet("data."+resName+"_by_name", "id"),
+					resource.TestCheckResourceAttr("data."+resName+"_by_name", "name", name),
+				),
+			},
+		},
+	})
+}
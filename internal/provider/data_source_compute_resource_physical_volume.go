@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceComputeResourcePhysicalVolume() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: adoptRead("Compute Resource Physical Volume", dataSourceComputeResourcePhysicalVolumeRead),
+		Schema: map[string]*schema.Schema{
+			"compute_resource_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "ID of the Compute Resource",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"vg_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Name of the LVM volume group",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"vg_size": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vg_free": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pv_used": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeResourcePhysicalVolumeRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	computeResourceID := d.Get("compute_resource_id").(int)
+	name := d.Get("vg_name").(string)
+
+	vv, err := client.ComputeResources.PhysicalVolumes(ctx, computeResourceID)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	for _, v := range vv {
+		if v.VGName != name {
+			continue
+		}
+
+		d.SetId(fmt.Sprintf("%d/%s", computeResourceID, v.VGName))
+		return newSchemaChainSetter(d).
+			Set("vg_size", v.VGSize).
+			Set("vg_free", v.VGFree).
+			Set("pv_used", v.PVUsed).
+			Error()
+	}
+
+	return errResourceNotFound
+}
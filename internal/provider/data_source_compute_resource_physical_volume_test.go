@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccDatasourceComputeResourcePhysicalVolume(t *testing.T) {
+	name := generateResourceName()
+	resName := "data.solus_compute_resource_physical_volume." + name
+
+	var computeResourceID int
+	if raw := os.Getenv("SOLUS_TEST_COMPUTE_RESOURCE_ID"); raw != "" {
+		var err error
+		computeResourceID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+	vgName := os.Getenv("SOLUS_TEST_VOLUME_GROUP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			for _, e := range []string{"SOLUS_TEST_COMPUTE_RESOURCE_ID", "SOLUS_TEST_VOLUME_GROUP"} {
+				assert.NotEmptyf(t, os.Getenv(e), "%q environment variable must be set for acceptance tests", e)
+			}
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "solus_compute_resource_physical_volume" "%[1]s" {
+	compute_resource_id = %[2]d
+	vg_name = "%[3]s"
+}
+`,
+					name,
+					computeResourceID,
+					vgName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "vg_name", vgName),
+					resource.TestCheckResourceAttrSet(resName, "vg_size"),
+					resource.TestCheckResourceAttrSet(resName, "vg_free"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceComputeResourceThinPool() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: adoptRead("Compute Resource Thin Pool", dataSourceComputeResourceThinPoolRead),
+		Schema: map[string]*schema.Schema{
+			"compute_resource_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "ID of the Compute Resource",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Name of the ThinLVM pool logical volume",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"vg_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_percent": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata_size": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata_percent": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeResourceThinPoolRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	computeResourceID := d.Get("compute_resource_id").(int)
+	name := d.Get("name").(string)
+
+	pp, err := client.ComputeResources.ThinPools(ctx, computeResourceID)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	for _, p := range pp {
+		if p.LVName != name {
+			continue
+		}
+
+		d.SetId(fmt.Sprintf("%d/%s/%s", computeResourceID, p.VGName, p.LVName))
+		return newSchemaChainSetter(d).
+			Set("vg_name", p.VGName).
+			Set("size", p.LVSize).
+			Set("data_percent", p.DataPercent).
+			Set("metadata_size", p.LVMetadataSize).
+			Set("metadata_percent", p.MetadataPrecent).
+			Error()
+	}
+
+	return errResourceNotFound
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccDatasourceComputeResourceThinPool(t *testing.T) {
+	name := generateResourceName()
+	resName := "data.solus_compute_resource_thin_pool." + name
+
+	var computeResourceID int
+	if raw := os.Getenv("SOLUS_TEST_COMPUTE_RESOURCE_ID"); raw != "" {
+		var err error
+		computeResourceID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+	poolName := os.Getenv("SOLUS_TEST_THIN_POOL")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			for _, e := range []string{"SOLUS_TEST_COMPUTE_RESOURCE_ID", "SOLUS_TEST_THIN_POOL"} {
+				assert.NotEmptyf(t, os.Getenv(e), "%q environment variable must be set for acceptance tests", e)
+			}
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "solus_compute_resource_thin_pool" "%[1]s" {
+	compute_resource_id = %[2]d
+	name = "%[3]s"
+}
+`,
+					name,
+					computeResourceID,
+					poolName,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "name", poolName),
+					resource.TestCheckResourceAttrSet(resName, "vg_name"),
+					resource.TestCheckResourceAttrSet(resName, "size"),
+				),
+			},
+		},
+	})
+}
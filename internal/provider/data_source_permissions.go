@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: adoptRead("Permissions", dataSourcePermissionsRead),
+		Schema: map[string]*schema.Schema{
+			"permissions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All available permissions",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of all available permissions",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePermissionsRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	permissions, err := listPermissions(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	var (
+		pp    = make([]map[string]interface{}, 0, len(permissions))
+		names = make([]string, 0, len(permissions))
+	)
+	for _, p := range permissions {
+		pp = append(pp, map[string]interface{}{
+			"id":   p.ID,
+			"name": p.Name,
+		})
+		names = append(names, p.Name)
+	}
+
+	// Permissions is a global catalogue, so it has no ID.
+	d.SetId("permissions")
+	return newSchemaChainSetter(d).
+		Set("permissions", pp).
+		Set("names", names).
+		Error()
+}
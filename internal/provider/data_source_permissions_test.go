@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourcePermissions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "solus_permissions" "all" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.solus_permissions.all", "id", "permissions"),
+					resource.TestCheckResourceAttrSet("data.solus_permissions.all", "permissions.0.id"),
+					resource.TestCheckResourceAttrSet("data.solus_permissions.all", "permissions.0.name"),
+					resource.TestCheckResourceAttrSet("data.solus_permissions.all", "names.0"),
+				),
+			},
+		},
+	})
+}
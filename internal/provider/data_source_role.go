@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceRole() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: adoptRead("Role", dataSourceRoleRead),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Name of the Role",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"users_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRoleRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	r, err := client.Roles.GetByName(ctx, d.Get("name").(string))
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	// Role returned by the list doesn't contain permissions, so we should
+	// fetch it separately.
+	res, err := client.RoleGet(ctx, r.ID)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	permissions := make([]string, 0, len(res.Permissions))
+	for _, p := range res.Permissions {
+		permissions = append(permissions, p.Name)
+	}
+
+	return newSchemaChainSetter(d).
+		SetID(res.ID).
+		Set("name", res.Name).
+		Set("permissions", permissions).
+		Set("is_default", res.IsDefault).
+		Set("users_count", res.UsersCount).
+		Error()
+}
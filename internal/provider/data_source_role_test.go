@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceRole(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_role." + name
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					`
+data "solus_permissions" "%[1]s" {}
+
+resource "solus_role" "%[1]s" {
+	name = "%[1]s"
+	permissions = [data.solus_permissions.%[1]s.names[0]]
+}
+
+data "solus_role" "%[1]s" {
+	name = solus_role.%[1]s.name
+}
+`,
+					name,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data."+resName, "id", resName, "id"),
+					resource.TestCheckResourceAttr("data."+resName, "name", name),
+					resource.TestCheckResourceAttr("data."+resName, "permissions.#", "1"),
+					resource.TestCheckResourceAttr("data."+resName, "is_default", "false"),
+				),
+			},
+		},
+	})
+}
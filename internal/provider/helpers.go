@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
+	"math/big"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -66,6 +68,110 @@ func listOfIDs(i interface{}) []int {
 	return res
 }
 
+// setOfIDs converts a set of IDs to a slice. Unlike `listOfIDs` it returns an
+// empty slice instead of nil, so the result can be sent to clear a property.
+func setOfIDs(i interface{}) []int {
+	res := []int{}
+	if i == nil {
+		return res
+	}
+
+	for _, v := range i.(*schema.Set).List() { //nolint:errcheck // We are sure about type.
+		res = append(res, v.(int))
+	}
+
+	return res
+}
+
+func listOfStrings(i interface{}) []string {
+	if i == nil {
+		return nil
+	}
+
+	vv := i.([]interface{}) //nolint:errcheck // We are sure about type.
+	if len(vv) == 0 {
+		return nil
+	}
+
+	res := make([]string, 0, len(vv))
+	for _, v := range vv {
+		res = append(res, v.(string))
+	}
+
+	return res
+}
+
+func mapOfStrings(i interface{}) map[string]string {
+	if i == nil {
+		return nil
+	}
+
+	m := i.(map[string]interface{}) //nolint:errcheck // We are sure about type.
+	res := make(map[string]string, len(m))
+	for k, v := range m {
+		res[k] = v.(string)
+	}
+
+	return res
+}
+
+// generatePassword generates a random password with specified length. The
+// password contains at least one lowercase letter, uppercase letter, digit and
+// special character.
+func generatePassword(length int) (string, error) {
+	classes := []string{
+		"abcdefghijklmnopqrstuvwxyz",
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+		"0123456789",
+		"!#%+-.:=?@_~",
+	}
+
+	if length < len(classes) {
+		return "", fmt.Errorf("password length should be at least %d", len(classes))
+	}
+
+	randomInt := func(upper int) (int, error) {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(upper)))
+		if err != nil {
+			return 0, err
+		}
+		return int(n.Int64()), nil
+	}
+
+	all := ""
+	for _, c := range classes {
+		all += c
+	}
+
+	res := make([]byte, length)
+	for i := range res {
+		// Take a character from each class first to be sure that all of
+		// them are present in the password.
+		charset := all
+		if i < len(classes) {
+			charset = classes[i]
+		}
+
+		n, err := randomInt(len(charset))
+		if err != nil {
+			return "", err
+		}
+		res[i] = charset[n]
+	}
+
+	// Shuffle the password to make positions of the required characters
+	// unpredictable.
+	for i := len(res) - 1; i > 0; i-- {
+		j, err := randomInt(i + 1)
+		if err != nil {
+			return "", err
+		}
+		res[i], res[j] = res[j], res[i]
+	}
+
+	return string(res), nil
+}
+
 func adoptCreate(resourceName string, fn operationFunc) schema.CreateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		err := fn(ctx, m.(*client), d)
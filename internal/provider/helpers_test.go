@@ -5,6 +5,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -104,3 +105,31 @@ func Test_newNullableIntForID(t *testing.T) {
 		assert.False(t, i.Valid)
 	})
 }
+
+func Test_generatePassword(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		p, err := generatePassword(24)
+		require.NoError(t, err)
+
+		assert.Len(t, p, 24)
+		assert.True(t, strings.ContainsAny(p, "abcdefghijklmnopqrstuvwxyz"))
+		assert.True(t, strings.ContainsAny(p, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"))
+		assert.True(t, strings.ContainsAny(p, "0123456789"))
+		assert.True(t, strings.ContainsAny(p, "!#%+-.:=?@_~"))
+
+		p2, err := generatePassword(24)
+		require.NoError(t, err)
+		assert.NotEqual(t, p, p2)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		_, err := generatePassword(3)
+		assert.EqualError(t, err, "password length should be at least 4")
+	})
+}
+
+func Test_setOfIDs(t *testing.T) {
+	assert.Equal(t, []int{}, setOfIDs(nil))
+	assert.Equal(t, []int{}, setOfIDs(schema.NewSet(schema.HashInt, nil)))
+	assert.ElementsMatch(t, []int{1, 2}, setOfIDs(schema.NewSet(schema.HashInt, []interface{}{2, 1})))
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"bytes"
+	_ "crypto/sha256" // Register hash function required for PGP encryption.
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/openpgp"        //nolint:staticcheck // There is no replacement in the vendored modules.
+	"golang.org/x/crypto/openpgp/armor"  //nolint:staticcheck // There is no replacement in the vendored modules.
+	"golang.org/x/crypto/openpgp/packet" //nolint:staticcheck // There is no replacement in the vendored modules.
+)
+
+// encryptWithPGPKey encrypts the value with the specified public key. The key
+// should be either ASCII armored or base64 encoded binary. The encrypted value
+// is returned base64 encoded together with the key fingerprint.
+func encryptWithPGPKey(key, value string) (encrypted, fingerprint string, err error) {
+	e, err := readPGPEntity(key)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read PGP key: %w", err)
+	}
+
+	var buf bytes.Buffer
+	w, err := openpgp.Encrypt(&buf, []*openpgp.Entity{e}, nil, nil, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encrypt with PGP key: %w", err)
+	}
+
+	if _, err := w.Write([]byte(value)); err != nil {
+		return "", "", fmt.Errorf("failed to encrypt with PGP key: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return "", "", fmt.Errorf("failed to encrypt with PGP key: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), hex.EncodeToString(e.PrimaryKey.Fingerprint[:]), nil
+}
+
+func readPGPEntity(key string) (*openpgp.Entity, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil, errors.New("key is empty")
+	}
+
+	if strings.HasPrefix(key, "-----BEGIN") {
+		b, err := armor.Decode(strings.NewReader(key))
+		if err != nil {
+			return nil, err
+		}
+
+		if b.Type != openpgp.PublicKeyType {
+			return nil, fmt.Errorf("unexpected key type %q", b.Type)
+		}
+		return openpgp.ReadEntity(packet.NewReader(b.Body))
+	}
+
+	b, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+	return openpgp.ReadEntity(packet.NewReader(bytes.NewReader(b)))
+}
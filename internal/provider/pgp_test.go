@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"        //nolint:staticcheck // There is no replacement in the vendored modules.
+	"golang.org/x/crypto/openpgp/armor"  //nolint:staticcheck // There is no replacement in the vendored modules.
+	"golang.org/x/crypto/openpgp/packet" //nolint:staticcheck // There is no replacement in the vendored modules.
+)
+
+func Test_encryptWithPGPKey(t *testing.T) {
+	config := &packet.Config{RSABits: 1024}
+	e, err := openpgp.NewEntity("test", "", "test@example.com", config)
+	require.NoError(t, err)
+
+	// Generated key doesn't have any preferred hash functions, so we specify
+	// them the same way as GnuPG does.
+	for _, id := range e.Identities {
+		id.SelfSignature.PreferredHash = []uint8{8} // SHA256.
+		require.NoError(t, id.SelfSignature.SignUserId(id.UserId.Id, e.PrimaryKey, e.PrivateKey, config))
+	}
+
+	var binaryKey bytes.Buffer
+	require.NoError(t, e.Serialize(&binaryKey))
+
+	var armoredKey bytes.Buffer
+	w, err := armor.Encode(&armoredKey, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, e.Serialize(w))
+	require.NoError(t, w.Close())
+
+	decrypt := func(t *testing.T, encrypted string) string {
+		b, err := base64.StdEncoding.DecodeString(encrypted)
+		require.NoError(t, err)
+
+		md, err := openpgp.ReadMessage(bytes.NewReader(b), openpgp.EntityList{e}, nil, nil)
+		require.NoError(t, err)
+
+		actual, err := io.ReadAll(md.UnverifiedBody)
+		require.NoError(t, err)
+		return string(actual)
+	}
+
+	cc := map[string]string{
+		"base64":  base64.StdEncoding.EncodeToString(binaryKey.Bytes()),
+		"armored": armoredKey.String(),
+	}
+
+	for name, key := range cc {
+		t.Run(name, func(t *testing.T) {
+			encrypted, fingerprint, err := encryptWithPGPKey(key, "secret")
+			require.NoError(t, err)
+
+			assert.Equal(t, hex.EncodeToString(e.PrimaryKey.Fingerprint[:]), fingerprint)
+			assert.Equal(t, "secret", decrypt(t, encrypted))
+		})
+	}
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, _, err := encryptWithPGPKey("foo", "secret")
+		assert.Error(t, err)
+	})
+
+	t.Run("empty key", func(t *testing.T) {
+		_, _, err := encryptWithPGPKey(" ", "secret")
+		assert.EqualError(t, err, "failed to read PGP key: key is empty")
+	})
+}
@@ -47,25 +47,48 @@ func New() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"solus_icon":             dataSourceIcon(),
-			"solus_ip_block":         dataSourceIPBlock(),
-			"solus_location":         dataSourceLocation(),
-			"solus_os_image":         dataSourceOsImage(),
-			"solus_os_image_version": dataSourceOsImageVersion(),
-			"solus_plan":             dataSourcePlan(),
-			"solus_project":          dataSourceProject(),
-			"solus_ssh_key":          dataSourceSSHKey(),
+			"solus_application":                      dataSourceApplication(),
+			"solus_compute_resource_physical_volume": dataSourceComputeResourcePhysicalVolume(),
+			"solus_compute_resource_thin_pool":       dataSourceComputeResourceThinPool(),
+			"solus_icon":                             dataSourceIcon(),
+			"solus_ip_block":                         dataSourceIPBlock(),
+			"solus_location":                         dataSourceLocation(),
+			"solus_os_image":                         dataSourceOsImage(),
+			"solus_os_image_version":                 dataSourceOsImageVersion(),
+			"solus_permissions":                      dataSourcePermissions(),
+			"solus_plan":                             dataSourcePlan(),
+			"solus_project":                          dataSourceProject(),
+			"solus_role":                             dataSourceRole(),
+			"solus_ssh_key":                          dataSourceSSHKey(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"solus_ip_block":         resourceIPBlock(),
-			"solus_location":         resourceLocation(),
-			"solus_os_image":         resourceOSImage(),
-			"solus_os_image_version": resourceOSImageVersion(),
-			"solus_plan":             resourcePlan(),
-			"solus_project":          resourceProject(),
-			"solus_ssh_key":          resourceSSHKey(),
-			"solus_virtual_server":   resourceVirtualServer(),
+			"solus_application":                 resourceApplication(),
+			"solus_backup_node":                 resourceBackupNode(),
+			"solus_compute_resource":            resourceComputeResource(),
+			"solus_compute_resource_evacuation": resourceComputeResourceEvacuation(),
+			"solus_compute_resource_network":    resourceComputeResourceNetwork(),
+			"solus_compute_resource_settings":   resourceComputeResourceSettings(),
+			"solus_compute_resource_storage":    resourceComputeResourceStorage(),
+			"solus_dns_settings":                resourceDNSSettings(),
+			"solus_ip_address":                  resourceIPAddress(),
+			"solus_ip_block":                    resourceIPBlock(),
+			"solus_license":                     resourceLicense(),
+			"solus_location":                    resourceLocation(),
+			"solus_notification_template":       resourceNotificationTemplate(),
+			"solus_os_image":                    resourceOSImage(),
+			"solus_os_image_version":            resourceOSImageVersion(),
+			"solus_plan":                        resourcePlan(),
+			"solus_project":                     resourceProject(),
+			"solus_reverse_dns_record":          resourceReverseDNSRecord(),
+			"solus_role":                        resourceRole(),
+			"solus_settings":                    resourceSettings(),
+			"solus_ssh_key":                     resourceSSHKey(),
+			"solus_user":                        resourceUser(),
+			"solus_virtual_server":              resourceVirtualServer(),
+			"solus_virtual_server_backup":       resourceVirtualServerBackup(),
+			"solus_virtual_server_restore":      resourceVirtualServerRestore(),
+			"solus_virtual_server_snapshot":     resourceVirtualServerSnapshot(),
 		},
 
 		ConfigureContextFunc: configureProvider,
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+func resourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("Application", resourceApplicationCreate),
+		ReadContext:   adoptRead("Application", resourceApplicationRead),
+		UpdateContext: adoptUpdate("Application", resourceApplicationUpdate),
+		DeleteContext: adoptDelete("Application", resourceApplicationDelete),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "URL of the application image",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"icon_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cloud_init_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validationIsCloudInitVersion,
+			},
+			"user_data_template": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"login_link_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(solus.LoginLinkTypeNone),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(solus.LoginLinkTypeNone),
+					string(solus.LoginLinkTypeURL),
+					string(solus.LoginLinkTypeJSCode),
+					string(solus.LoginLinkTypeInfo),
+				}, false),
+			},
+			"login_link_content": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL pattern, JS code or information depends on login link type",
+			},
+			"json_schema": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "JSON schema of the application data",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"is_visible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"is_builtin": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	res, err := client.ApplicationCreate(ctx, buildApplicationRequest(d))
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(strconv.Itoa(res.ID))
+	return resourceApplicationRead(ctx, client, d)
+}
+
+func resourceApplicationRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := client.ApplicationGet(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	return newSchemaChainSetter(d).
+		SetID(res.ID).
+		Set("name", res.Name).
+		Set("url", res.URL).
+		Set("icon_id", res.Icon.ID).
+		Set("cloud_init_version", res.CloudInitVersion).
+		Set("user_data_template", res.UserData).
+		Set("login_link_type", string(res.LoginLink.Type)).
+		Set("login_link_content", res.LoginLink.Content).
+		Set("json_schema", res.JSONSchema).
+		Set("is_default", res.IsDefault).
+		Set("is_visible", res.IsVisible).
+		Set("is_builtin", res.IsBuiltin).
+		Error()
+}
+
+func resourceApplicationUpdate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := client.ApplicationUpdate(ctx, id, buildApplicationRequest(d))
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(strconv.Itoa(res.ID))
+	return resourceApplicationRead(ctx, client, d)
+}
+
+func resourceApplicationDelete(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return normalizeAPIError(client.ApplicationDelete(ctx, id))
+}
+
+func buildApplicationRequest(d *schema.ResourceData) applicationRequest {
+	return applicationRequest{
+		ApplicationCreateRequest: solus.ApplicationCreateRequest{
+			Name:             d.Get("name").(string),
+			URL:              d.Get("url").(string),
+			CloudInitVersion: d.Get("cloud_init_version").(string),
+			UserDataTemplate: d.Get("user_data_template").(string),
+			JSONSchema:       d.Get("json_schema").(string),
+			IsVisible:        d.Get("is_visible").(bool),
+			LoginLink: solus.LoginLink{
+				Type:    solus.LoginLinkType(d.Get("login_link_type").(string)),
+				Content: d.Get("login_link_content").(string),
+			},
+		},
+		IconID:    newNullableIntForID(d.Get("icon_id").(int)),
+		IsDefault: d.Get("is_default").(bool),
+	}
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/solusio/solus-go-sdk"
+)
+
+func TestAccResourceApplication(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_application." + name
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			// Create resource.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_application" "%[1]s" {
+	name = "%[1]s"
+	url = "https://images.example.com/%[1]s.qcow2"
+	cloud_init_version = "v2"
+	user_data_template = "#cloud-config"
+	json_schema = jsonencode({
+		type = "object"
+		properties = {}
+	})
+}
+`,
+					name,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "name", name),
+					resource.TestCheckResourceAttr(resName, "url", "https://images.example.com/"+name+".qcow2"),
+					resource.TestCheckResourceAttr(resName, "cloud_init_version", "v2"),
+					resource.TestCheckResourceAttr(resName, "user_data_template", "#cloud-config"),
+					resource.TestCheckResourceAttr(resName, "login_link_type", "none"),
+					resource.TestCheckResourceAttr(resName, "is_default", "false"),
+					resource.TestCheckResourceAttr(resName, "is_visible", "true"),
+					resource.TestCheckResourceAttr(resName, "is_builtin", "false"),
+				),
+			},
+
+			// Update resource.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_application" "%[1]s" {
+	name = "%[1]s-changed"
+	url = "https://images.example.com/%[1]s.qcow2"
+	cloud_init_version = "v2"
+	user_data_template = "#cloud-config"
+	login_link_type = "url"
+	login_link_content = "https://{{ ips.0 }}"
+	json_schema = jsonencode({
+		type = "object"
+		properties = {}
+	})
+	is_visible = false
+}
+`,
+					name,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "name", name+"-changed"),
+					resource.TestCheckResourceAttr(resName, "login_link_type", "url"),
+					resource.TestCheckResourceAttr(resName, "login_link_content", "https://{{ ips.0 }}"),
+					resource.TestCheckResourceAttr(resName, "is_visible", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "solus_application" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.ApplicationGet(context.Background(), id)
+		if err == nil {
+			return fmt.Errorf("application %d still exists", id)
+		}
+
+		if !solus.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+func resourceBackupNode() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("Backup Node", resourceBackupNodeCreate),
+		ReadContext:   adoptRead("Backup Node", resourceBackupNodeRead),
+		UpdateContext: adoptUpdate("Backup Node", resourceBackupNodeUpdate),
+		DeleteContext: adoptDelete("Backup Node", resourceBackupNodeDelete),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Type - SSH + rsync
+			"ssh_rsync": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"ssh_rsync", "hetzner_storage_box"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.Any(
+								validation.IsIPAddress,
+								validationIsDomainName,
+							),
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      22,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"login": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							Description:  "SSH private key",
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"storage_path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			// Type - Hetzner Storage Box
+			"hetzner_storage_box": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"ssh_rsync", "hetzner_storage_box"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validationIsDomainName,
+						},
+						"login": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							Description:  "SSH private key",
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"compute_resource_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+
+			"backups_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_backups_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBackupNodeCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	req, err := buildBackupNodeRequest(d)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.BackupNodes.Create(ctx, req)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(strconv.Itoa(res.ID))
+	return resourceBackupNodeRead(ctx, client, d)
+}
+
+func resourceBackupNodeRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := client.BackupNodeGet(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	computeResourceIDs := make([]int, 0, len(res.ComputeResources))
+	for _, cr := range res.ComputeResources {
+		computeResourceIDs = append(computeResourceIDs, cr.ID)
+	}
+
+	s := newSchemaChainSetter(d).
+		SetID(res.ID).
+		Set("name", res.Name).
+		Set("compute_resource_ids", computeResourceIDs).
+		Set("backups_count", res.BackupsCount).
+		Set("total_backups_size", res.TotalBackupsSize)
+
+	// Private key isn't returned by the API, so it will be taken from the
+	// current state.
+	switch res.Type {
+	case solus.BackupNodeTypeSSHRsync:
+		s.
+			Set("ssh_rsync", backupNodeCredentialsToResource(
+				d.Get("ssh_rsync"),
+				res.Credentials,
+				"host", "port", "login", "storage_path",
+			)).
+			Set("hetzner_storage_box", nil)
+
+	case solus.BackupNodeTypeHetznerStorageBox:
+		s.
+			Set("hetzner_storage_box", backupNodeCredentialsToResource(
+				d.Get("hetzner_storage_box"),
+				res.Credentials,
+				"host", "login",
+			)).
+			Set("ssh_rsync", nil)
+	}
+
+	return s.Error()
+}
+
+func resourceBackupNodeUpdate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	req, err := buildBackupNodeRequest(d)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.BackupNodeUpdate(ctx, id, backupNodeUpdateRequest{
+		BackupNodeRequest: req,
+		ComputeResources:  req.ComputeResources,
+	})
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(strconv.Itoa(res.ID))
+	return resourceBackupNodeRead(ctx, client, d)
+}
+
+func resourceBackupNodeDelete(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return normalizeAPIError(client.BackupNodes.Delete(ctx, id))
+}
+
+func buildBackupNodeRequest(d *schema.ResourceData) (solus.BackupNodeRequest, error) {
+	req := solus.BackupNodeRequest{
+		Name:             d.Get("name").(string),
+		ComputeResources: setOfIDs(d.Get("compute_resource_ids")),
+	}
+
+	if v, ok := d.GetOk("ssh_rsync"); ok {
+		m := v.([]interface{})[0].(map[string]interface{}) //nolint:errcheck // We are sure about type.
+		req.Type = solus.BackupNodeTypeSSHRsync
+		req.Credentials = solus.BackupNodeSSHRsyncCredentials(
+			m["host"].(string),
+			m["port"].(int),
+			m["login"].(string),
+			m["key"].(string),
+			m["storage_path"].(string),
+		)
+		return req, nil
+	}
+
+	if v, ok := d.GetOk("hetzner_storage_box"); ok {
+		m := v.([]interface{})[0].(map[string]interface{}) //nolint:errcheck // We are sure about type.
+		req.Type = solus.BackupNodeTypeHetznerStorageBox
+		req.Credentials = solus.BackupNodeHetznerStorageBoxCredentials(
+			m["host"].(string),
+			m["login"].(string),
+			m["key"].(string),
+		)
+		return req, nil
+	}
+
+	return solus.BackupNodeRequest{}, errors.New("unhandled backup node type")
+}
+
+// backupNodeCredentialsToResource overrides values of the credentials block
+// from the state by specified keys of the credentials returned by the API.
+func backupNodeCredentialsToResource(
+	current interface{},
+	credentials map[string]interface{},
+	keys ...string,
+) []interface{} {
+	m := map[string]interface{}{}
+	if vv, ok := current.([]interface{}); ok && len(vv) > 0 {
+		if v, ok := vv[0].(map[string]interface{}); ok {
+			for k, val := range v {
+				m[k] = val
+			}
+		}
+	}
+
+	for _, k := range keys {
+		switch v := credentials[k].(type) {
+		case string:
+			m[k] = v
+		case float64:
+			// JSON numbers are decoded as float64.
+			m[k] = int(v)
+		}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/solusio/solus-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccResourceBackupNode(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_backup_node." + name
+
+	privateKey, err := generateSSHPrivateKey()
+	require.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckBackupNodeDestroy,
+		Steps: []resource.TestStep{
+			// Create resource.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_backup_node" "%[1]s" {
+	name = "%[1]s"
+	ssh_rsync {
+		host = "backup.example.com"
+		login = "root"
+		key = <<EOT
+%[2]s
+EOT
+		storage_path = "/backups"
+	}
+}
+`,
+					name,
+					privateKey,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "name", name),
+					resource.TestCheckResourceAttr(resName, "ssh_rsync.0.host", "backup.example.com"),
+					resource.TestCheckResourceAttr(resName, "ssh_rsync.0.port", "22"),
+					resource.TestCheckResourceAttr(resName, "ssh_rsync.0.storage_path", "/backups"),
+					resource.TestCheckResourceAttr(resName, "hetzner_storage_box.#", "0"),
+					resource.TestCheckResourceAttr(resName, "backups_count", "0"),
+				),
+			},
+
+			// Change type.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_backup_node" "%[1]s" {
+	name = "%[1]s-changed"
+	hetzner_storage_box {
+		host = "u1.your-storagebox.de"
+		login = "u1"
+		key = <<EOT
+%[2]s
+EOT
+	}
+}
+`,
+					name,
+					privateKey,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "name", name+"-changed"),
+					resource.TestCheckResourceAttr(resName, "hetzner_storage_box.0.host", "u1.your-storagebox.de"),
+					resource.TestCheckResourceAttr(resName, "hetzner_storage_box.0.login", "u1"),
+					resource.TestCheckResourceAttr(resName, "ssh_rsync.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBackupNodeDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "solus_backup_node" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.BackupNodeGet(context.Background(), id)
+		if err == nil {
+			return fmt.Errorf("backup node %d still exists", id)
+		}
+
+		if !solus.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Test_backupNodeCredentialsToResource(t *testing.T) {
+	current := []interface{}{
+		map[string]interface{}{
+			"host":         "old.example.com",
+			"port":         22,
+			"login":        "root",
+			"key":          "private key",
+			"storage_path": "/backups",
+		},
+	}
+
+	actual := backupNodeCredentialsToResource(
+		current,
+		map[string]interface{}{
+			"host":  "new.example.com",
+			"port":  float64(2222),
+			"login": "backup",
+			"other": "value",
+		},
+		"host", "port", "login", "storage_path",
+	)
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"host":         "new.example.com",
+			"port":         2222,
+			"login":        "backup",
+			"key":          "private key",
+			"storage_path": "/backups",
+		},
+	}, actual)
+}
+
+func generateSSHPrivateKey() (string, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	err = pem.Encode(&buf, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
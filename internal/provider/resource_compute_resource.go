@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/solusio/terraform-provider-solus/internal/timer"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+func resourceComputeResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("Compute Resource", resourceComputeResourceCreate),
+		ReadContext:   adoptRead("Compute Resource", resourceComputeResourceRead),
+		UpdateContext: adoptUpdate("Compute Resource", resourceComputeResourceUpdate),
+		DeleteContext: adoptDelete("Compute Resource", resourceComputeResourceDelete),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					validation.IsIPAddress,
+					validationIsDomainName,
+				),
+			},
+
+			// SSH connection properties are used only once for the agent
+			// installation, so it's impossible to change them without
+			// reinstalling.
+			"login": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "root",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      22,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"password", "key"},
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				Description:  "SSH private key",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"password", "key"},
+			},
+			"agent_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+
+			"locations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+			"ip_blocks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete compute resource even if it has virtual servers",
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeResourceCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	req := solus.ComputerResourceCreateRequest{
+		Name:      d.Get("name").(string),
+		Host:      d.Get("host").(string),
+		Login:     d.Get("login").(string),
+		Port:      d.Get("port").(int),
+		Type:      solus.ComputeResourceAuthTypePassword,
+		Password:  d.Get("password").(string),
+		AgentPort: d.Get("agent_port").(int),
+		IPBlocks:  setOfIDs(d.Get("ip_blocks")),
+		Locations: setOfIDs(d.Get("locations")),
+	}
+
+	if key, ok := d.GetOk("key"); ok {
+		req.Type = solus.ComputeResourceAuthTypeKey
+		req.Key = key.(string)
+	}
+
+	res, err := client.ComputeResources.Create(ctx, req)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	// Compute resource is already created even if commissioning will fail, so
+	// we should store it in the state to be able to delete it later.
+	d.SetId(strconv.Itoa(res.ID))
+
+	err = resourceComputeResourceWaitFor(
+		ctx,
+		client,
+		res.ID,
+		[]solus.ComputerResourceStatus{solus.ComputeResourceStatusCommissioning},
+		[]solus.ComputerResourceStatus{
+			solus.ComputeResourceStatusActive,
+			solus.ComputeResourceStatusConfigureNetwork,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeResourceRead(ctx, client, d)
+}
+
+func resourceComputeResourceRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := client.ComputeResources.Get(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	locations := make([]int, 0, len(res.Locations))
+	for _, l := range res.Locations {
+		locations = append(locations, l.ID)
+	}
+
+	ipBlocks := make([]int, 0, len(res.IPBlocks))
+	for _, b := range res.IPBlocks {
+		ipBlocks = append(ipBlocks, b.ID)
+	}
+
+	return newSchemaChainSetter(d).
+		SetID(res.ID).
+		Set("name", res.Name).
+		Set("host", res.Host).
+		Set("agent_port", res.AgentPort).
+		Set("locations", locations).
+		Set("ip_blocks", ipBlocks).
+		Set("status", res.Status).
+		Set("version", res.Version).
+		Error()
+}
+
+func resourceComputeResourceUpdate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if !d.HasChanges("name", "locations", "ip_blocks") {
+		// Only `force_delete` was changed, it's stored in the state only.
+		return resourceComputeResourceRead(ctx, client, d)
+	}
+
+	res, err := client.ComputeResourcePatch(ctx, id, computeResourceUpdateRequest{
+		ComputerResourceCreateRequest: solus.ComputerResourceCreateRequest{
+			Name: d.Get("name").(string),
+		},
+		IPBlocks:  setOfIDs(d.Get("ip_blocks")),
+		Locations: setOfIDs(d.Get("locations")),
+	})
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(strconv.Itoa(res.ID))
+	return resourceComputeResourceRead(ctx, client, d)
+}
+
+func resourceComputeResourceDelete(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return normalizeAPIError(client.ComputeResources.Delete(ctx, id, d.Get("force_delete").(bool)))
+}
+
+// resourceComputeResourceWaitFor waits until compute resource will have one of
+// target statuses. Any status which is neither pending nor target one is
+// considered as failure.
+func resourceComputeResourceWaitFor(
+	ctx context.Context,
+	client *client,
+	id int,
+	pending []solus.ComputerResourceStatus,
+	target []solus.ComputerResourceStatus,
+) error {
+	hasStatus := func(ss []solus.ComputerResourceStatus, s solus.ComputerResourceStatus) bool {
+		for _, v := range ss {
+			if v == s {
+				return true
+			}
+		}
+		return false
+	}
+
+	return timer.WaitFor(ctx, 5*time.Second, func() (bool, error) {
+		tflog.Trace(ctx, "Wait for Compute Resource will be in one of statuses", "id", id, "statuses", target)
+		res, err := client.ComputeResources.Get(ctx, id)
+		if err != nil {
+			return false, normalizeAPIError(err)
+		}
+
+		switch {
+		case hasStatus(target, res.Status):
+			return true, nil
+
+		case hasStatus(pending, res.Status):
+			return false, nil
+
+		case res.Status == solus.ComputeResourceStatusFailed:
+			steps, err := client.ComputeResources.InstallSteps(ctx, id)
+			if err != nil {
+				return false, fmt.Errorf(
+					"compute resource commissioning failed, also failed to get install steps: %w",
+					normalizeAPIError(err),
+				)
+			}
+			return false, fmt.Errorf("compute resource commissioning failed: %w", installStepsError(steps))
+
+		default:
+			return false, fmt.Errorf("unexpected compute resource status %q", res.Status)
+		}
+	})
+}
+
+// installStepsError builds an error which describes all failed install steps.
+func installStepsError(steps []solus.ComputeResourceInstallStep) error {
+	var ss []string
+	for _, s := range steps {
+		if s.Status != solus.ComputeResourceInstallStepStatusError {
+			continue
+		}
+
+		ss = append(ss, fmt.Sprintf("%s: %s", s.Title, s.StatusText))
+	}
+
+	if len(ss) == 0 {
+		return errors.New("no failed install steps")
+	}
+	return errors.New(strings.Join(ss, "; "))
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+// resourceComputeResourceEvacuation is an action resource. All virtual servers
+// are migrated from the compute resource on creation, so changing of any
+// property leads to a new evacuation. There is nothing to read or undo
+// afterwards.
+func resourceComputeResourceEvacuation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("Compute Resource Evacuation", resourceComputeResourceEvacuationCreate),
+		ReadContext:   adoptRead("Compute Resource Evacuation", resourceComputeResourceEvacuationRead),
+		DeleteContext: adoptDelete("Compute Resource Evacuation", resourceComputeResourceEvacuationDelete),
+
+		Schema: map[string]*schema.Schema{
+			"compute_resource_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"destination_compute_resource_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"live_migration": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Migrate virtual servers without stopping them",
+			},
+			"preserve_ips": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Keep the current IP addresses of virtual servers",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values which leads to a new evacuation when changed",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"migration_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the servers migration, 0 if there were no virtual servers to migrate",
+			},
+			"error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Error of the servers migration, empty if it's finished successfully",
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Migration result of each virtual server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_migrated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceComputeResourceEvacuationCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	computeResourceID := d.Get("compute_resource_id").(int)
+
+	servers, err := computeResourceVirtualServers(ctx, client, computeResourceID)
+	if err != nil {
+		return err
+	}
+
+	// Evacuation isn't a real entity, so an unique ID is generated.
+	d.SetId(resource.UniqueId())
+
+	if len(servers) == 0 {
+		tflog.Info(ctx, "There are no Virtual Servers to evacuate", "compute_resource_id", computeResourceID)
+		return newSchemaChainSetter(d).
+			Set("migration_id", 0).
+			Set("error", "").
+			Set("servers", []interface{}{}).
+			Error()
+	}
+
+	ids := make([]int, 0, len(servers))
+	for _, s := range servers {
+		ids = append(ids, s.ID)
+	}
+
+	m, err := client.ServersMigrationCreate(ctx, solus.ServersMigrationRequest{
+		IsLive:                       d.Get("live_migration").(bool),
+		PreserveIPs:                  d.Get("preserve_ips").(bool),
+		DestinationComputeResourceID: d.Get("destination_compute_resource_id").(int),
+		Servers:                      ids,
+	})
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	tasks := make(map[int]solus.Task, len(m.Children))
+	for _, c := range m.Children {
+		t, err := waitForTaskFinished(ctx, client, c.ID)
+		if err != nil {
+			return err
+		}
+		tasks[c.ComputeResourceVMID] = t
+	}
+
+	parent, err := waitForTaskFinished(ctx, client, m.Task.ID)
+	if err != nil {
+		return err
+	}
+
+	var migrationError string
+	if err := taskError(parent); err != nil {
+		migrationError = err.Error()
+	}
+
+	var (
+		results = make([]interface{}, 0, len(servers))
+		failed  []string
+	)
+	for _, s := range servers {
+		t, ok := tasks[s.ID]
+		r := flattenEvacuationResult(s, t, ok)
+		if !r["is_migrated"].(bool) { //nolint:errcheck // We are sure about type.
+			failed = append(failed, fmt.Sprintf("%s: %s", s.Name, r["error"]))
+		}
+		results = append(results, r)
+	}
+
+	err = newSchemaChainSetter(d).
+		Set("migration_id", m.ID).
+		Set("error", migrationError).
+		Set("servers", results).
+		Error()
+	if err != nil {
+		return err
+	}
+
+	// The result is kept in the state anyway, so the resource is tainted and
+	// the next apply evacuates remaining virtual servers.
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to migrate virtual servers: %s", strings.Join(failed, "; "))
+	}
+	if migrationError != "" {
+		return fmt.Errorf("failed to migrate virtual servers: %s", migrationError)
+	}
+	return nil
+}
+
+func resourceComputeResourceEvacuationRead(context.Context, *client, *schema.ResourceData) error {
+	return nil
+}
+
+func resourceComputeResourceEvacuationDelete(context.Context, *client, *schema.ResourceData) error {
+	return nil
+}
+
+// computeResourceVirtualServers returns all virtual servers placed on the
+// specified compute resource.
+func computeResourceVirtualServers(ctx context.Context, client *client, id int) ([]solus.VirtualServer, error) {
+	res, err := client.VirtualServers.List(ctx, new(solus.FilterVirtualServers).ByComputeResourceID(id))
+	if err != nil {
+		return nil, normalizeAPIError(err)
+	}
+
+	var servers []solus.VirtualServer
+	for {
+		servers = append(servers, res.Data...)
+
+		if !res.Next(ctx) {
+			break
+		}
+	}
+
+	return servers, normalizeAPIError(res.Err())
+}
+
+func flattenEvacuationResult(s solus.VirtualServer, t solus.Task, hasTask bool) map[string]interface{} {
+	res := map[string]interface{}{
+		"id":          s.ID,
+		"name":        s.Name,
+		"is_migrated": false,
+		"error":       "",
+	}
+
+	if !hasTask {
+		res["error"] = "migration task isn't found"
+		return res
+	}
+
+	if err := taskError(t); err != nil {
+		res["error"] = err.Error()
+		return res
+	}
+
+	res["is_migrated"] = true
+	return res
+}
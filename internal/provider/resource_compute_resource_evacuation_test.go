@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/solusio/solus-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccResourceComputeResourceEvacuation(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_compute_resource_evacuation." + name
+
+	var locationID int
+	if raw := os.Getenv("SOLUS_TEST_LOCATION_ID"); raw != "" {
+		var err error
+		locationID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+
+	var sourceID, destinationID int
+	if raw := os.Getenv("SOLUS_TEST_COMPUTE_RESOURCE_ID"); raw != "" {
+		var err error
+		sourceID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+	if raw := os.Getenv("SOLUS_TEST_DESTINATION_COMPUTE_RESOURCE_ID"); raw != "" {
+		var err error
+		destinationID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+
+	config := func(trigger string) string {
+		return fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+	compute_resource_id = %[3]d
+}
+
+resource "solus_compute_resource_evacuation" "%[1]s" {
+	compute_resource_id = %[3]d
+	destination_compute_resource_id = %[4]d
+	triggers = {
+		trigger = "%[5]s"
+	}
+
+	depends_on = [solus_virtual_server.%[1]s]
+}
+`,
+			name,
+			locationID,
+			sourceID,
+			destinationID,
+			trigger,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			ee := []string{
+				"SOLUS_TEST_LOCATION_ID",
+				"SOLUS_TEST_COMPUTE_RESOURCE_ID",
+				"SOLUS_TEST_DESTINATION_COMPUTE_RESOURCE_ID",
+			}
+			for _, e := range ee {
+				assert.NotEmptyf(t, os.Getenv(e), "%q environment variable must be set for acceptance tests", e)
+			}
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVirtualServerDestroy,
+		Steps: []resource.TestStep{
+			// Evacuate the virtual server.
+			{
+				Config: config("first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "migration_id"),
+					resource.TestCheckResourceAttr(resName, "error", ""),
+					resource.TestCheckResourceAttrPair(resName, "servers.0.id", "solus_virtual_server."+name, "id"),
+					resource.TestCheckResourceAttr(resName, "servers.0.is_migrated", "true"),
+					resource.TestCheckResourceAttr(resName, "servers.0.error", ""),
+				),
+			},
+
+			// There is nothing to evacuate anymore.
+			{
+				Config: config("second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "migration_id", "0"),
+					resource.TestCheckResourceAttr(resName, "servers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func Test_flattenEvacuationResult(t *testing.T) {
+	s := solus.VirtualServer{ID: 1, Name: "vs.example.com"}
+
+	t.Run("migrated", func(t *testing.T) {
+		assert.Equal(t, map[string]interface{}{
+			"id":          1,
+			"name":        "vs.example.com",
+			"is_migrated": true,
+			"error":       "",
+		}, flattenEvacuationResult(s, solus.Task{Status: solus.TaskStatusDone}, true))
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		actual := flattenEvacuationResult(s, solus.Task{
+			Action: solus.TaskActionServerMigrate,
+			Status: solus.TaskStatusFailed,
+			Output: "not enough disk space",
+		}, true)
+
+		assert.Equal(t, false, actual["is_migrated"])
+		assert.Equal(t, `task "vm-migrate" is failed: not enough disk space`, actual["error"])
+	})
+
+	t.Run("without task", func(t *testing.T) {
+		actual := flattenEvacuationResult(s, solus.Task{}, false)
+
+		assert.Equal(t, false, actual["is_migrated"])
+		assert.Equal(t, "migration task isn't found", actual["error"])
+	})
+}
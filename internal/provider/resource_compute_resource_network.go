@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+// resourceComputeResourceNetwork sets up network of a compute resource. Network
+// can't be unset through the API, so it's left configured on destroy.
+func resourceComputeResourceNetwork() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("Compute Resource Network", resourceComputeResourceNetworkCreate),
+		ReadContext:   adoptRead("Compute Resource Network", resourceComputeResourceNetworkRead),
+		DeleteContext: adoptDelete("Compute Resource Network", resourceComputeResourceNetworkDelete),
+
+		Schema: map[string]*schema.Schema{
+			"compute_resource_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(solus.ComputeResourceSettingsNetworkTypeRouted),
+					string(solus.ComputeResourceSettingsNetworkTypeBridged),
+				}, false),
+			},
+
+			// Network interface may be chosen by one of the following properties.
+			"interface_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Name of the network interface, e.g. eth0",
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"interface_name", "interface_ip", "interface_cidr"},
+			},
+			"interface_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "IP address assigned to the network interface",
+				ValidateFunc: validation.IsIPAddress,
+				ExactlyOneOf: []string{"interface_name", "interface_ip", "interface_cidr"},
+			},
+			"interface_cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Network which contains IP address of the network interface",
+				ValidateFunc: validation.IsCIDRNetwork(0, 128),
+				ExactlyOneOf: []string{"interface_name", "interface_ip", "interface_cidr"},
+			},
+
+			"interface_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeResourceNetworkCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id := d.Get("compute_resource_id").(int)
+
+	nn, err := client.ComputeResources.Networks(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	n, err := findComputeResourceNetwork(
+		nn,
+		d.Get("interface_name").(string),
+		d.Get("interface_ip").(string),
+		d.Get("interface_cidr").(string),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to find network interface: %w", err)
+	}
+
+	err = client.ComputeResources.SetUpNetwork(ctx, id, solus.SetupNetworkRequest{
+		ID:   n.ID,
+		Type: solus.ComputeResourceSettingsNetworkType(d.Get("type").(string)),
+	})
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	err = resourceComputeResourceWaitFor(
+		ctx,
+		client,
+		id,
+		[]solus.ComputerResourceStatus{
+			solus.ComputeResourceStatusConfigureNetwork,
+			solus.ComputeResourceStatusCommissioning,
+		},
+		[]solus.ComputerResourceStatus{solus.ComputeResourceStatusActive},
+	)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(id))
+	return newSchemaChainSetter(d).
+		Set("interface_id", n.ID).
+		Set("ip", n.IP).
+		Error()
+}
+
+func resourceComputeResourceNetworkRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := client.ComputeResources.Get(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	return newSchemaChainSetter(d).
+		SetID(res.ID).
+		Set("compute_resource_id", res.ID).
+		Set("type", res.Settings.Network.Type).
+		Error()
+}
+
+func resourceComputeResourceNetworkDelete(context.Context, *client, *schema.ResourceData) error {
+	return nil
+}
+
+// findComputeResourceNetwork finds exactly one network which matches specified
+// name, IP or CIDR. Only one of them should be non-empty.
+func findComputeResourceNetwork(
+	nn []solus.ComputeResourceNetwork,
+	name string,
+	ip string,
+	cidr string,
+) (solus.ComputeResourceNetwork, error) {
+	var ipNet *net.IPNet
+	if cidr != "" {
+		var err error
+		_, ipNet, err = net.ParseCIDR(cidr)
+		if err != nil {
+			return solus.ComputeResourceNetwork{}, err
+		}
+	}
+
+	var res []solus.ComputeResourceNetwork
+	for _, n := range nn {
+		switch {
+		case name != "" && n.Name == name,
+			ip != "" && net.ParseIP(ip).Equal(net.ParseIP(n.IP)),
+			ipNet != nil && ipNet.Contains(net.ParseIP(n.IP)):
+			res = append(res, n)
+		}
+	}
+
+	switch len(res) {
+	case 0:
+		return solus.ComputeResourceNetwork{}, errResourceNotFound
+	case 1:
+		return res[0], nil
+	default:
+		return solus.ComputeResourceNetwork{}, errTooManyResults
+	}
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/solusio/solus-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccResourceComputeResourceNetwork(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_compute_resource_network." + name
+
+	host := os.Getenv("SOLUS_TEST_COMPUTE_RESOURCE_HOST")
+	password := os.Getenv("SOLUS_TEST_COMPUTE_RESOURCE_PASSWORD")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			for _, e := range []string{"SOLUS_TEST_COMPUTE_RESOURCE_HOST", "SOLUS_TEST_COMPUTE_RESOURCE_PASSWORD"} {
+				assert.NotEmptyf(t, os.Getenv(e), "%q environment variable must be set for acceptance tests", e)
+			}
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "solus_compute_resource" "%[1]s" {
+	name = "%[1]s"
+	host = "%[2]s"
+	password = "%[3]s"
+	force_delete = true
+}
+
+resource "solus_compute_resource_network" "%[1]s" {
+	compute_resource_id = solus_compute_resource.%[1]s.id
+	type = "routed"
+	interface_ip = "%[2]s"
+}
+`,
+					name,
+					host,
+					password,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "type", "routed"),
+					resource.TestCheckResourceAttrSet(resName, "interface_id"),
+					resource.TestCheckResourceAttr(resName, "ip", host),
+				),
+			},
+		},
+	})
+}
+
+func Test_findComputeResourceNetwork(t *testing.T) {
+	nn := []solus.ComputeResourceNetwork{
+		{
+			ID:   "1",
+			Name: "eth0",
+			IP:   "192.0.2.10",
+		},
+		{
+			ID:   "2",
+			Name: "eth1",
+			IP:   "198.51.100.10",
+		},
+		{
+			ID:   "3",
+			Name: "eth2",
+			IP:   "198.51.100.20",
+		},
+	}
+
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]struct {
+			name       string
+			ip         string
+			cidr       string
+			expectedID string
+		}{
+			"by name": {name: "eth1", expectedID: "2"},
+			"by ip":   {ip: "198.51.100.20", expectedID: "3"},
+			"by cidr": {cidr: "192.0.2.0/24", expectedID: "1"},
+		}
+
+		for name, c := range cc {
+			c := c
+			t.Run(name, func(t *testing.T) {
+				n, err := findComputeResourceNetwork(nn, c.name, c.ip, c.cidr)
+				require.NoError(t, err)
+				assert.Equal(t, c.expectedID, n.ID)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("not found", func(t *testing.T) {
+			_, err := findComputeResourceNetwork(nn, "eth3", "", "")
+			assert.ErrorIs(t, err, errResourceNotFound)
+		})
+
+		t.Run("too many results", func(t *testing.T) {
+			_, err := findComputeResourceNetwork(nn, "", "", "198.51.100.0/24")
+			assert.ErrorIs(t, err, errTooManyResults)
+		})
+	})
+}
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+// resourceComputeResourceSettings manages settings of a compute resource. They
+// exist as long as the compute resource exists, so destroy keeps them as is.
+func resourceComputeResourceSettings() *schema.Resource {
+	createLimitResource := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"unlimited": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"total": {
+						Type:         schema.TypeFloat,
+						Optional:     true,
+						Default:      0.0,
+						ValidateFunc: validation.FloatAtLeast(0),
+					},
+					"used": {
+						Type:     schema.TypeFloat,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: adoptCreate("Compute Resource Settings", resourceComputeResourceSettingsCreate),
+		ReadContext:   adoptRead("Compute Resource Settings", resourceComputeResourceSettingsRead),
+		UpdateContext: adoptUpdate("Compute Resource Settings", resourceComputeResourceSettingsUpdate),
+		DeleteContext: adoptDelete("Compute Resource Settings", resourceComputeResourceSettingsDelete),
+
+		// All properties are optional and computed, so only specified settings
+		// will be changed. Other settings will keep their current values.
+		Schema: map[string]*schema.Schema{
+			"compute_resource_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cache_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"iso_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"backup_tmp_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"vnc_proxy_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"limits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vm":   createLimitResource(),
+						"hdd":  createLimitResource(),
+						"ram":  createLimitResource(),
+						"vcpu": createLimitResource(),
+					},
+				},
+			},
+			"balance_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(solus.ComputeResourceBalanceStrategyRoundRobin),
+					string(solus.ComputeResourceBalanceStrategyRandom),
+					string(solus.ComputeResourceBalanceStrategyMostStorageAvailable),
+				}, false),
+			},
+			"virtualization_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validationIsVirtualizationType,
+				},
+			},
+		},
+	}
+}
+
+func resourceComputeResourceSettingsCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id := d.Get("compute_resource_id").(int)
+
+	if err := resourceComputeResourceSettingsApply(ctx, client, d, id); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(id))
+	return resourceComputeResourceSettingsRead(ctx, client, d)
+}
+
+func resourceComputeResourceSettingsRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := client.ComputeResources.Get(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	virtualizationTypes := make([]string, 0, len(res.Settings.VirtualizationTypes))
+	for _, t := range res.Settings.VirtualizationTypes {
+		virtualizationTypes = append(virtualizationTypes, string(t))
+	}
+
+	return newSchemaChainSetter(d).
+		SetID(res.ID).
+		Set("compute_resource_id", res.ID).
+		Set("cache_path", res.Settings.CachePath).
+		Set("iso_path", res.Settings.ISOPath).
+		Set("backup_tmp_path", res.Settings.BackupTmpPath).
+		Set("vnc_proxy_port", res.Settings.VNCProxyPort).
+		Set("limits", computeResourceSettingsLimitsToResource(res.Settings.Limits)).
+		Set("balance_strategy", res.Settings.BalanceStrategy).
+		Set("virtualization_types", virtualizationTypes).
+		Error()
+}
+
+func resourceComputeResourceSettingsUpdate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := resourceComputeResourceSettingsApply(ctx, client, d, id); err != nil {
+		return err
+	}
+
+	return resourceComputeResourceSettingsRead(ctx, client, d)
+}
+
+func resourceComputeResourceSettingsDelete(context.Context, *client, *schema.ResourceData) error {
+	return nil
+}
+
+func resourceComputeResourceSettingsApply(ctx context.Context, client *client, d *schema.ResourceData, id int) error {
+	// Settings are updated by PUT request, so we should send actual values for
+	// all settings which aren't managed by this resource, e.g. network.
+	cr, err := client.ComputeResources.Get(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	_, err = client.ComputeResources.SettingsUpdate(ctx, id, buildComputeResourceSettings(d, cr.Settings))
+	return normalizeAPIError(err)
+}
+
+// buildComputeResourceSettings overrides specified settings by values from the
+// resource.
+func buildComputeResourceSettings(
+	d *schema.ResourceData,
+	s solus.ComputeResourceSettings,
+) solus.ComputeResourceSettings {
+	if v, ok := d.GetOk("cache_path"); ok {
+		s.CachePath = v.(string)
+	}
+	if v, ok := d.GetOk("iso_path"); ok {
+		s.ISOPath = v.(string)
+	}
+	if v, ok := d.GetOk("backup_tmp_path"); ok {
+		s.BackupTmpPath = v.(string)
+	}
+	if v, ok := d.GetOk("vnc_proxy_port"); ok {
+		s.VNCProxyPort = v.(int)
+	}
+	if v, ok := d.GetOk("balance_strategy"); ok {
+		s.BalanceStrategy = solus.ComputeResourceBalanceStrategy(v.(string))
+	}
+	if v, ok := d.GetOk("virtualization_types"); ok {
+		vv := v.([]interface{}) //nolint:errcheck // We are sure about type.
+		s.VirtualizationTypes = make([]solus.VirtualizationType, 0, len(vv))
+		for _, t := range vv {
+			s.VirtualizationTypes = append(s.VirtualizationTypes, solus.VirtualizationType(t.(string)))
+		}
+	}
+
+	if v, ok := d.GetOk("limits"); ok {
+		mm := v.([]interface{}) //nolint:errcheck // We are sure about type.
+		if m, ok := mm[0].(map[string]interface{}); ok {
+			s.Limits.VM = resourceToComputeResourceSettingsLimit(m["vm"], s.Limits.VM)
+			s.Limits.HDD = resourceToComputeResourceSettingsLimit(m["hdd"], s.Limits.HDD)
+			s.Limits.RAM = resourceToComputeResourceSettingsLimit(m["ram"], s.Limits.RAM)
+			s.Limits.VCPU = resourceToComputeResourceSettingsLimit(m["vcpu"], s.Limits.VCPU)
+		}
+	}
+
+	return s
+}
+
+func resourceToComputeResourceSettingsLimit(
+	i interface{},
+	l solus.ComputeResourceSettingsLimit,
+) solus.ComputeResourceSettingsLimit {
+	mm, ok := i.([]interface{})
+	if !ok || len(mm) == 0 {
+		return l
+	}
+
+	m, ok := mm[0].(map[string]interface{})
+	if !ok {
+		return l
+	}
+
+	return solus.ComputeResourceSettingsLimit{
+		Unlimited: m["unlimited"].(bool),
+		Total:     float32(m["total"].(float64)),
+	}
+}
+
+func computeResourceSettingsLimitsToResource(l solus.ComputeResourceSettingsLimits) []interface{} {
+	limitToResource := func(l solus.ComputeResourceSettingsLimit) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"unlimited": l.Unlimited,
+				"total":     float64(l.Total),
+				"used":      float64(l.Used),
+			},
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"vm":   limitToResource(l.VM),
+			"hdd":  limitToResource(l.HDD),
+			"ram":  limitToResource(l.RAM),
+			"vcpu": limitToResource(l.VCPU),
+		},
+	}
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/solusio/solus-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccResourceComputeResourceSettings(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_compute_resource_settings." + name
+
+	var computeResourceID int
+	if raw := os.Getenv("SOLUS_TEST_COMPUTE_RESOURCE_ID"); raw != "" {
+		var err error
+		computeResourceID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+
+	checker := func(balanceStrategy solus.ComputeResourceBalanceStrategy, vmLimit string) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(resName, "id", strconv.Itoa(computeResourceID)),
+			resource.TestCheckResourceAttrSet(resName, "cache_path"),
+			resource.TestCheckResourceAttrSet(resName, "iso_path"),
+			resource.TestCheckResourceAttrSet(resName, "vnc_proxy_port"),
+			resource.TestCheckResourceAttr(resName, "balance_strategy", string(balanceStrategy)),
+			resource.TestCheckResourceAttr(resName, "limits.0.vm.0.unlimited", "false"),
+			resource.TestCheckResourceAttr(resName, "limits.0.vm.0.total", vmLimit),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			assert.NotEmpty(
+				t,
+				os.Getenv("SOLUS_TEST_COMPUTE_RESOURCE_ID"),
+				`"SOLUS_TEST_COMPUTE_RESOURCE_ID" environment variable must be set for acceptance tests`,
+			)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			// Create resource.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_compute_resource_settings" "%[1]s" {
+	compute_resource_id = %[2]d
+	balance_strategy = "random"
+	limits {
+		vm {
+			total = 100
+		}
+	}
+}
+`,
+					name,
+					computeResourceID,
+				),
+				Check: checker(solus.ComputeResourceBalanceStrategyRandom, "100"),
+			},
+
+			// Update created resource.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_compute_resource_settings" "%[1]s" {
+	compute_resource_id = %[2]d
+	balance_strategy = "round-robin"
+	limits {
+		vm {
+			total = 50
+		}
+	}
+}
+`,
+					name,
+					computeResourceID,
+				),
+				Check: checker(solus.ComputeResourceBalanceStrategyRoundRobin, "50"),
+			},
+		},
+	})
+}
+
+func Test_buildComputeResourceSettings(t *testing.T) {
+	current := solus.ComputeResourceSettings{
+		CachePath:     "/var/cache",
+		ISOPath:       "/var/iso",
+		BackupTmpPath: "/var/backups",
+		VNCProxyPort:  8800,
+		Limits: solus.ComputeResourceSettingsLimits{
+			VM:   solus.ComputeResourceSettingsLimit{Unlimited: true},
+			HDD:  solus.ComputeResourceSettingsLimit{Total: 100, Used: 10},
+			RAM:  solus.ComputeResourceSettingsLimit{Unlimited: true},
+			VCPU: solus.ComputeResourceSettingsLimit{Total: 8},
+		},
+		Network: solus.ComputeResourceSettingsNetwork{
+			Type: solus.ComputeResourceSettingsNetworkTypeBridged,
+		},
+		BalanceStrategy:     solus.ComputeResourceBalanceStrategyRoundRobin,
+		VirtualizationTypes: []solus.VirtualizationType{solus.VirtualizationTypeKVM},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceComputeResourceSettings().Schema, map[string]interface{}{
+		"compute_resource_id": 1,
+		"iso_path":            "/srv/iso",
+		"balance_strategy":    string(solus.ComputeResourceBalanceStrategyMostStorageAvailable),
+		"limits": []interface{}{
+			map[string]interface{}{
+				"vm": []interface{}{
+					map[string]interface{}{
+						"total": 42.0,
+					},
+				},
+			},
+		},
+	})
+
+	expected := current
+	expected.ISOPath = "/srv/iso"
+	expected.BalanceStrategy = solus.ComputeResourceBalanceStrategyMostStorageAvailable
+	expected.Limits.VM = solus.ComputeResourceSettingsLimit{Total: 42}
+
+	assert.Equal(t, expected, buildComputeResourceSettings(d, current))
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+func resourceComputeResourceStorage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("Compute Resource Storage", resourceComputeResourceStorageCreate),
+		ReadContext:   adoptRead("Compute Resource Storage", resourceComputeResourceStorageRead),
+		DeleteContext: adoptDelete("Compute Resource Storage", resourceComputeResourceStorageDelete),
+		CustomizeDiff: resourceComputeResourceStorageCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"compute_resource_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(solus.StorageTypeNameFB),
+					string(solus.StorageTypeNameLVM),
+					string(solus.StorageTypeNameThinLVM),
+					string(solus.StorageTypeNameNFS),
+					string(solus.StorageTypeNameVZ),
+				}, false),
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Path to the storage, volume group name for LVM storage or remote path for NFS storage",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"mount": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Local mount point for NFS storage",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"thin_pool": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "ThinLVM pool name, required for ThinLVM storage",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"is_available_for_balancing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"credentials": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Credentials for NFS storage",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"free_space": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"servers_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeResourceStorageCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("thin_pool") {
+		return nil
+	}
+
+	if d.Get("type").(string) == string(solus.StorageTypeNameThinLVM) && d.Get("thin_pool").(string) == "" {
+		return errors.New("thin_pool is required for ThinLVM storage")
+	}
+	return nil
+}
+
+func resourceComputeResourceStorageCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	computeResourceID := d.Get("compute_resource_id").(int)
+
+	res, err := client.ComputeResourceStorageCreate(ctx, computeResourceID, computeResourceStorageCreateRequest{
+		ComputeResourceStorageCreateRequest: solus.ComputeResourceStorageCreateRequest{
+			Type:                    solus.StorageTypeName(d.Get("type").(string)),
+			Path:                    d.Get("path").(string),
+			ThinPool:                d.Get("thin_pool").(string),
+			IsAvailableForBalancing: d.Get("is_available_for_balancing").(bool),
+		},
+		Mount:       d.Get("mount").(string),
+		Credentials: d.Get("credentials").(map[string]interface{}),
+	})
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(strconv.Itoa(res.ID))
+	return resourceComputeResourceStorageRead(ctx, client, d)
+}
+
+func resourceComputeResourceStorageRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Storage.Get(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	// Storage may be shared between several compute resources, so we should
+	// check it's still attached to the specified one.
+	ss, err := client.ComputeResources.StorageList(ctx, d.Get("compute_resource_id").(int))
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	isAttached := false
+	for _, s := range ss {
+		if s.ID == res.ID {
+			isAttached = true
+			break
+		}
+	}
+	if !isAttached {
+		return fmt.Errorf("storage %d isn't attached to the compute resource: %w", res.ID, errResourceNotFound)
+	}
+
+	return newSchemaChainSetter(d).
+		SetID(res.ID).
+		Set("type", res.Type.Name).
+		Set("path", res.Path).
+		Set("mount", res.Mount).
+		Set("thin_pool", res.ThinPool).
+		Set("is_available_for_balancing", res.IsAvailableForBalancing).
+		Set("name", res.Name).
+		Set("free_space", res.FreeSpace).
+		Set("servers_count", res.ServersCount).
+		Error()
+}
+
+func resourceComputeResourceStorageDelete(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return normalizeAPIError(client.Storage.Delete(ctx, id))
+}
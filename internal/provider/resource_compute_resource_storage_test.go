@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/solusio/solus-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccResourceComputeResourceStorage(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_compute_resource_storage." + name
+
+	var computeResourceID int
+	if raw := os.Getenv("SOLUS_TEST_COMPUTE_RESOURCE_ID"); raw != "" {
+		var err error
+		computeResourceID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			assert.NotEmpty(
+				t,
+				os.Getenv("SOLUS_TEST_COMPUTE_RESOURCE_ID"),
+				`"SOLUS_TEST_COMPUTE_RESOURCE_ID" environment variable must be set for acceptance tests`,
+			)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeResourceStorageDestroy,
+		Steps: []resource.TestStep{
+			// ThinLVM storage without pool.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_compute_resource_storage" "%[1]s" {
+	compute_resource_id = %[2]d
+	type = "thinlvm"
+	path = "%[1]s"
+}
+`,
+					name,
+					computeResourceID,
+				),
+				ExpectError: regexp.MustCompile("thin_pool is required for ThinLVM storage"),
+			},
+
+			// Create resource.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_compute_resource_storage" "%[1]s" {
+	compute_resource_id = %[2]d
+	type = "fb"
+	path = "/var/lib/%[1]s"
+	is_available_for_balancing = false
+}
+`,
+					name,
+					computeResourceID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "type", "fb"),
+					resource.TestCheckResourceAttr(resName, "path", "/var/lib/"+name),
+					resource.TestCheckResourceAttr(resName, "is_available_for_balancing", "false"),
+					resource.TestCheckResourceAttrSet(resName, "name"),
+					resource.TestCheckResourceAttrSet(resName, "free_space"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeResourceStorageDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "solus_compute_resource_storage" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.Storage.Get(context.Background(), id)
+		if err == nil {
+			return fmt.Errorf("storage %d still exists", id)
+		}
+
+		if !solus.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/solusio/solus-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccResourceComputeResource(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_compute_resource." + name
+
+	host := os.Getenv("SOLUS_TEST_COMPUTE_RESOURCE_HOST")
+	password := os.Getenv("SOLUS_TEST_COMPUTE_RESOURCE_PASSWORD")
+
+	checker := func(name string) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(resName, "id"),
+			resource.TestCheckResourceAttr(resName, "name", name),
+			resource.TestCheckResourceAttr(resName, "host", host),
+			resource.TestCheckResourceAttrSet(resName, "agent_port"),
+			resource.TestCheckResourceAttrSet(resName, "status"),
+			resource.TestCheckResourceAttr(resName, "locations.#", "1"),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			for _, e := range []string{"SOLUS_TEST_COMPUTE_RESOURCE_HOST", "SOLUS_TEST_COMPUTE_RESOURCE_PASSWORD"} {
+				assert.NotEmptyf(t, os.Getenv(e), "%q environment variable must be set for acceptance tests", e)
+			}
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeResourceDestroy,
+		Steps: []resource.TestStep{
+			// Create resource.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_location" "%[1]s" {
+	name = "%[1]s"
+	description = "for acc test"
+}
+
+resource "solus_compute_resource" "%[1]s" {
+	name = "%[1]s"
+	host = "%[2]s"
+	password = "%[3]s"
+	locations = [
+		solus_location.%[1]s.id,
+	]
+	force_delete = true
+}
+`,
+					name,
+					host,
+					password,
+				),
+				Check: checker(name),
+			},
+
+			// Update created resource.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_location" "%[1]s" {
+	name = "%[1]s"
+	description = "for acc test"
+}
+
+resource "solus_compute_resource" "%[1]s" {
+	name = "%[1]s-changed"
+	host = "%[2]s"
+	password = "%[3]s"
+	locations = [
+		solus_location.%[1]s.id,
+	]
+	force_delete = true
+}
+`,
+					name,
+					host,
+					password,
+				),
+				Check: checker(name + "-changed"),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeResourceDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "solus_compute_resource" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.ComputeResources.Get(context.Background(), id)
+		if err == nil {
+			return fmt.Errorf("compute resource %d still exists", id)
+		}
+
+		if !solus.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return testAccCheckLocationDestroy(s)
+}
+
+func Test_installStepsError(t *testing.T) {
+	t.Run("with failed steps", func(t *testing.T) {
+		err := installStepsError([]solus.ComputeResourceInstallStep{
+			{
+				Title:      "Connect",
+				Status:     solus.ComputeResourceInstallStepStatusDone,
+				StatusText: "ok",
+			},
+			{
+				Title:      "Install agent",
+				Status:     solus.ComputeResourceInstallStepStatusError,
+				StatusText: "permission denied",
+			},
+			{
+				Title:      "Configure storage",
+				Status:     solus.ComputeResourceInstallStepStatusError,
+				StatusText: "no space left",
+			},
+		})
+		assert.EqualError(t, err, "Install agent: permission denied; Configure storage: no space left")
+	})
+
+	t.Run("without failed steps", func(t *testing.T) {
+		err := installStepsError([]solus.ComputeResourceInstallStep{
+			{
+				Title:  "Connect",
+				Status: solus.ComputeResourceInstallStepStatusRunning,
+			},
+		})
+		assert.EqualError(t, err, "no failed install steps")
+	})
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dnsSettingsID is an ID of the DNS settings resource. DNS settings are a part
+// of global settings, so there is only one instance of them.
+const dnsSettingsID = "dns_settings"
+
+// dnsDriverPowerDNS is a type of PowerDNS driver.
+const dnsDriverPowerDNS = "power_dns"
+
+// resourceDNSSettings manages DNS section of global settings. Like the rest of
+// settings it's kept as is on destroy.
+func resourceDNSSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("DNS Settings", resourceDNSSettingsCreate),
+		ReadContext:   adoptRead("DNS Settings", resourceDNSSettingsRead),
+		UpdateContext: adoptUpdate("DNS Settings", resourceDNSSettingsUpdate),
+		DeleteContext: adoptDelete("DNS Settings", resourceDNSSettingsDelete),
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  dnsDriverPowerDNS,
+				ValidateFunc: validation.StringInSlice([]string{
+					dnsDriverPowerDNS,
+				}, false),
+			},
+			"server_hostname_template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Template of new virtual servers hostname, e.g. `{{ip-dashed}}.example.com`",
+				ValidateFunc: validationIsTemplate("ip-dashed", "random-prefix", "location", "user-id"),
+			},
+			"register_fqdn_on_server_create": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"reverse_dns_domain_template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Template of reverse DNS domain, e.g. `{{ip-dashed}}.example.com`",
+				ValidateFunc: validationIsTemplate("ip-dashed", "hostname"),
+			},
+			"power_dns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.Any(
+								validation.IsIPAddress,
+								validationIsDomainName,
+							),
+						},
+						"api_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDNSSettingsCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	if err := resourceDNSSettingsApply(ctx, client, d); err != nil {
+		return err
+	}
+
+	d.SetId(dnsSettingsID)
+	return resourceDNSSettingsRead(ctx, client, d)
+}
+
+func resourceDNSSettingsRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	res, err := client.Settings.Get(ctx)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	s := newSchemaChainSetter(d).
+		Set("type", res.DNS.Type).
+		Set("server_hostname_template", res.DNS.ServerHostnameTemplate).
+		Set("register_fqdn_on_server_create", res.DNS.RegisterFQDNOnServerCreate).
+		Set("reverse_dns_domain_template", res.DNS.ReverseDNSDomainTemplate)
+
+	if m, ok := settingsBlock(d, "power_dns"); ok {
+		// API key isn't returned by the API, so it will be taken from the
+		// current state.
+		s.Set("power_dns", []interface{}{map[string]interface{}{
+			"host":    res.DNS.Drivers.PowerDNS.Host,
+			"api_key": m["api_key"],
+		}})
+	}
+
+	return s.Error()
+}
+
+func resourceDNSSettingsUpdate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	if err := resourceDNSSettingsApply(ctx, client, d); err != nil {
+		return err
+	}
+	return resourceDNSSettingsRead(ctx, client, d)
+}
+
+func resourceDNSSettingsDelete(context.Context, *client, *schema.ResourceData) error {
+	return nil
+}
+
+func resourceDNSSettingsApply(ctx context.Context, client *client, d *schema.ResourceData) error {
+	_, err := client.SettingsPatch(ctx, buildDNSSettingsRequest(d))
+	return normalizeAPIError(err)
+}
+
+// buildDNSSettingsRequest builds a request which contains only DNS section, so
+// other settings are left as is. All DNS properties are sent, so removed ones
+// are cleared.
+func buildDNSSettingsRequest(d *schema.ResourceData) settingsUpdateRequest {
+	dns := settingsDNS{
+		Type:                       d.Get("type").(string),
+		ServerHostnameTemplate:     d.Get("server_hostname_template").(string),
+		RegisterFQDNOnServerCreate: d.Get("register_fqdn_on_server_create").(bool),
+		ReverseDNSDomainTemplate:   d.Get("reverse_dns_domain_template").(string),
+	}
+
+	if m, ok := settingsBlock(d, "power_dns"); ok {
+		dns.Drivers.PowerDNS = settingsDNSDriversPowerDNS{
+			Host:   m["host"].(string),
+			APIKey: m["api_key"].(string),
+		}
+	}
+
+	return settingsUpdateRequest{
+		DNS: &dns,
+	}
+}
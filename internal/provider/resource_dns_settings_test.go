@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccResourceDNSSettings(t *testing.T) {
+	resName := "solus_dns_settings.dns"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown placeholder.
+			{
+				Config: `
+resource "solus_dns_settings" "dns" {
+	server_hostname_template = "{{foo}}.example.com"
+}
+`,
+				ExpectError: regexp.MustCompile(`unknown placeholder "foo"`),
+			},
+
+			// Create resource.
+			{
+				Config: `
+resource "solus_dns_settings" "dns" {
+	server_hostname_template = "{{ip-dashed}}.example.com"
+	register_fqdn_on_server_create = true
+	reverse_dns_domain_template = "{{hostname}}"
+	power_dns {
+		host = "dns.example.com"
+		api_key = "secret"
+	}
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "dns_settings"),
+					resource.TestCheckResourceAttr(resName, "type", "power_dns"),
+					resource.TestCheckResourceAttr(resName, "server_hostname_template", "{{ip-dashed}}.example.com"),
+					resource.TestCheckResourceAttr(resName, "register_fqdn_on_server_create", "true"),
+					resource.TestCheckResourceAttr(resName, "reverse_dns_domain_template", "{{hostname}}"),
+					resource.TestCheckResourceAttr(resName, "power_dns.0.host", "dns.example.com"),
+					resource.TestCheckResourceAttr(resName, "power_dns.0.api_key", "secret"),
+				),
+			},
+
+			// Update resource.
+			{
+				Config: `
+resource "solus_dns_settings" "dns" {
+	server_hostname_template = "{{ip-dashed}}.example.com"
+	power_dns {
+		host = "dns.example.com"
+		api_key = "secret"
+	}
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "register_fqdn_on_server_create", "false"),
+					resource.TestCheckResourceAttr(resName, "reverse_dns_domain_template", ""),
+				),
+			},
+
+			// Clear resource.
+			{
+				Config: `
+resource "solus_dns_settings" "dns" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "server_hostname_template", ""),
+					resource.TestCheckResourceAttr(resName, "power_dns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func Test_buildDNSSettingsRequest(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDNSSettings().Schema, map[string]interface{}{
+		"server_hostname_template": "{{ip-dashed}}.example.com",
+		"power_dns": []interface{}{
+			map[string]interface{}{
+				"host":    "dns.example.com",
+				"api_key": "secret",
+			},
+		},
+	})
+
+	b, err := json.Marshal(buildDNSSettingsRequest(d))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"dns": {
+			"type": "power_dns",
+			"server_hostname_template": "{{ip-dashed}}.example.com",
+			"register_fqdn_on_server_create": false,
+			"reverse_dns_domain_template": "",
+			"drivers": {
+				"power_dns": {
+					"host": "dns.example.com",
+					"api_key": "secret"
+				}
+			}
+		}
+	}`, string(b))
+}
+
+func Test_buildDNSSettingsRequest_clear(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDNSSettings().Schema, map[string]interface{}{})
+
+	b, err := json.Marshal(buildDNSSettingsRequest(d))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"dns": {
+			"type": "power_dns",
+			"server_hostname_template": "",
+			"register_fqdn_on_server_create": false,
+			"reverse_dns_domain_template": "",
+			"drivers": {
+				"power_dns": {
+					"host": "",
+					"api_key": ""
+				}
+			}
+		}
+	}`, string(b))
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceIPAddress() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("IP Address", resourceIPAddressCreate),
+		ReadContext:   adoptRead("IP Address", resourceIPAddressRead),
+		UpdateContext: adoptUpdate("IP Address", resourceIPAddressUpdate),
+		DeleteContext: adoptDelete("IP Address", resourceIPAddressDelete),
+
+		CustomizeDiff: resourceIPAddressCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"ip_block_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"virtual_server_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Virtual server to attach the IP address to as an additional IP",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reverse_dns": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceIPAddressCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// Check is performed only if the IP address will be attached to the
+	// already existing virtual server.
+	if !d.HasChange("virtual_server_id") || !d.NewValueKnown("virtual_server_id") {
+		return nil
+	}
+
+	id := d.Get("virtual_server_id").(int)
+	if id == 0 {
+		return nil
+	}
+
+	p, err := virtualServerPlan(ctx, m.(*client), id)
+	if err != nil {
+		return err
+	}
+
+	if !p.IsAdditionalIPsAvailable {
+		return fmt.Errorf("additional IPs aren't available for the plan %q of the virtual server", p.Name)
+	}
+	return nil
+}
+
+func resourceIPAddressCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	res, err := client.IPBlocks.IPAddressCreate(ctx, d.Get("ip_block_id").(int))
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(strconv.Itoa(res.ID))
+
+	if serverID, ok := d.GetOk("virtual_server_id"); ok {
+		if err := resourceIPAddressAttach(ctx, client, serverID.(int), res.ID); err != nil {
+			return err
+		}
+	}
+
+	return resourceIPAddressRead(ctx, client, d)
+}
+
+func resourceIPAddressRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := client.IPAddressGet(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	var reverseDNS string
+	if res.ReverseDNS != nil {
+		reverseDNS = res.ReverseDNS.Domain
+	}
+
+	var serverID int
+	if res.Server != nil {
+		serverID = res.Server.ID
+	}
+
+	return newSchemaChainSetter(d).
+		SetID(res.ID).
+		Set("ip_block_id", res.IPBlock.ID).
+		Set("virtual_server_id", serverID).
+		Set("ip", res.IP).
+		Set("reverse_dns", reverseDNS).
+		Error()
+}
+
+func resourceIPAddressUpdate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("virtual_server_id") {
+		oldID, newID := d.GetChange("virtual_server_id")
+
+		if oldID.(int) != 0 {
+			if err := resourceIPAddressDetach(ctx, client, oldID.(int), id); err != nil {
+				return err
+			}
+		}
+
+		if newID.(int) != 0 {
+			if err := resourceIPAddressAttach(ctx, client, newID.(int), id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceIPAddressRead(ctx, client, d)
+}
+
+func resourceIPAddressDelete(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Attached IP address can't be deleted.
+	if serverID := d.Get("virtual_server_id").(int); serverID != 0 {
+		if err := resourceIPAddressDetach(ctx, client, serverID, id); err != nil {
+			return err
+		}
+	}
+
+	return normalizeAPIError(client.IPBlocks.IPAddressDelete(ctx, id))
+}
+
+func resourceIPAddressAttach(ctx context.Context, client *client, serverID, id int) error {
+	task, err := client.VirtualServerIPAttach(ctx, serverID, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	tflog.Trace(ctx, "Wait for IP Address will be attached to Virtual Server", "id", id, "server_id", serverID)
+	if err := waitForTask(ctx, client, task.ID); err != nil {
+		return fmt.Errorf("failed to attach IP address: %w", err)
+	}
+	return nil
+}
+
+func resourceIPAddressDetach(ctx context.Context, client *client, serverID, id int) error {
+	task, err := client.VirtualServerIPDetach(ctx, serverID, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	tflog.Trace(ctx, "Wait for IP Address will be detached from Virtual Server", "id", id, "server_id", serverID)
+	if err := waitForTask(ctx, client, task.ID); err != nil {
+		return fmt.Errorf("failed to detach IP address: %w", err)
+	}
+	return nil
+}
@@ -91,6 +91,27 @@ func resourceIPBlock() *schema.Resource {
 				ValidateFunc:  validation.IntBetween(83, 128),
 				ConflictsWith: []string{"netmask", "from", "to"},
 			},
+
+			"reverse_dns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Name of the reverse DNS zone",
+							ValidateFunc: validationIsDomainName,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -129,6 +150,17 @@ func resourceIPBlockRead(ctx context.Context, client *client, d *schema.Resource
 		Set("gateway", res.Gateway).
 		Set("type", res.Type)
 
+	// Disabled reverse DNS is tracked only if it's specified in the
+	// configuration.
+	if _, ok := d.GetOk("reverse_dns"); ok || res.ReverseDNS.Enabled {
+		s.Set("reverse_dns", []interface{}{
+			map[string]interface{}{
+				"zone":    res.ReverseDNS.Zone,
+				"enabled": res.ReverseDNS.Enabled,
+			},
+		})
+	}
+
 	switch res.Type {
 	case solus.IPv4:
 		s.
@@ -185,6 +217,16 @@ func buildIPBlockRequest(d *schema.ResourceData) (solus.IPBlockRequest, error) {
 		Type:    solus.IPVersion(d.Get("type").(string)),
 	}
 
+	// Removed reverse DNS means it should be disabled.
+	vv := d.Get("reverse_dns").([]interface{})
+	if len(vv) > 0 {
+		m := vv[0].(map[string]interface{}) //nolint:errcheck // We are sure about type.
+		req.ReverseDNS = solus.IPBlockReverseDNS{
+			Zone:    m["zone"].(string),
+			Enabled: m["enabled"].(bool),
+		}
+	}
+
 	switch req.Type {
 	case solus.IPv4:
 		req.Netmask = d.Get("netmask").(string)
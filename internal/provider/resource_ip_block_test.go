@@ -150,6 +150,58 @@ resource "solus_ip_block" "%[1]s_ipv6" {
 	})
 }
 
+func TestAccResourceIPBlock_reverseDNS(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_ip_block." + name
+
+	config := func(reverseDNS string) string {
+		return fmt.Sprintf(`
+resource "solus_ip_block" "%[1]s" {
+	name = "%[1]s"
+	ns1 = "192.0.2.1"
+	ns2 = "192.0.2.2"
+	gateway = "192.0.2.3"
+	type = "IPv4"
+	netmask = "255.255.255.0"
+	from = "192.0.2.10"
+	to = "192.0.2.20"
+	%[2]s
+}
+`,
+			name,
+			reverseDNS,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIPBlockDestroy,
+		Steps: []resource.TestStep{
+			// Create resource with reverse DNS.
+			{
+				Config: config(`
+	reverse_dns {
+		zone = "2.0.192.in-addr.arpa"
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "reverse_dns.0.zone", "2.0.192.in-addr.arpa"),
+					resource.TestCheckResourceAttr(resName, "reverse_dns.0.enabled", "true"),
+				),
+			},
+
+			// Disable reverse DNS.
+			{
+				Config: config(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "reverse_dns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIPBlockDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*client)
 
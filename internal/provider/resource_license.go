@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+// licenseID is an ID of the license resource. There is only one license for
+// the whole installation.
+const licenseID = "license"
+
+// resourceLicense activates the license. The API has no way to deactivate it,
+// so the license stays active when the resource is destroyed.
+func resourceLicense() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("License", resourceLicenseCreate),
+		ReadContext:   adoptRead("License", resourceLicenseRead),
+		UpdateContext: adoptUpdate("License", resourceLicenseRead),
+		DeleteContext: adoptDelete("License", resourceLicenseDelete),
+
+		Schema: map[string]*schema.Schema{
+			"activation_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"check_validity": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail on refresh if the license is inactive or expired",
+			},
+
+			"key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_active": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"cpu_cores": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"cpu_cores_in_use": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLicenseCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	_, err := client.License.Activate(ctx, solus.LicenseActivateRequest{
+		ActivationCode: d.Get("activation_code").(string),
+	})
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(licenseID)
+	return resourceLicenseRead(ctx, client, d)
+}
+
+func resourceLicenseRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	res, err := client.LicenseGet(ctx)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	err = newSchemaChainSetter(d).
+		Set("key", res.Key).
+		Set("key_type", res.KeyType).
+		Set("product", res.Product).
+		Set("is_active", res.IsActive).
+		Set("cpu_cores", res.CPUCores).
+		Set("cpu_cores_in_use", res.CPUCoresInUse).
+		Set("expiration_date", res.ExpirationDate).
+		Set("update_date", res.UpdateDate).
+		Error()
+	if err != nil {
+		return err
+	}
+
+	if d.Get("check_validity").(bool) {
+		return licenseValidityError(res, time.Now())
+	}
+	return nil
+}
+
+func resourceLicenseDelete(context.Context, *client, *schema.ResourceData) error {
+	return nil
+}
+
+// licenseValidityError returns an error if the license is inactive or expired
+// at the specified moment.
+func licenseValidityError(l solus.License, now time.Time) error {
+	if !l.IsActive {
+		return errors.New("license is inactive")
+	}
+
+	if l.ExpirationDate == "" {
+		return nil
+	}
+
+	expiration, err := time.Parse(time.RFC3339, l.ExpirationDate)
+	if err != nil {
+		return fmt.Errorf("failed to parse license expiration date: %w", err)
+	}
+
+	if !now.Before(expiration) {
+		return fmt.Errorf("license expired at %s", l.ExpirationDate)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/solusio/solus-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccResourceLicense(t *testing.T) {
+	resName := "solus_license.license"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			assert.NotEmpty(
+				t,
+				os.Getenv("SOLUS_TEST_LICENSE_ACTIVATION_CODE"),
+				`"SOLUS_TEST_LICENSE_ACTIVATION_CODE" environment variable must be set for acceptance tests`,
+			)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "solus_license" "license" {
+	activation_code = "%s"
+	check_validity = true
+}
+`,
+					os.Getenv("SOLUS_TEST_LICENSE_ACTIVATION_CODE"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "license"),
+					resource.TestCheckResourceAttr(resName, "is_active", "true"),
+					resource.TestCheckResourceAttrSet(resName, "key"),
+					resource.TestCheckResourceAttrSet(resName, "cpu_cores"),
+					resource.TestCheckResourceAttrSet(resName, "cpu_cores_in_use"),
+					resource.TestCheckResourceAttrSet(resName, "expiration_date"),
+				),
+			},
+		},
+	})
+}
+
+func Test_licenseValidityError(t *testing.T) {
+	now := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, licenseValidityError(solus.License{
+			IsActive:       true,
+			ExpirationDate: "2021-11-01T00:00:00+00:00",
+		}, now))
+	})
+
+	t.Run("without expiration date", func(t *testing.T) {
+		assert.NoError(t, licenseValidityError(solus.License{IsActive: true}, now))
+	})
+
+	t.Run("inactive", func(t *testing.T) {
+		assert.EqualError(t, licenseValidityError(solus.License{
+			ExpirationDate: "2021-11-01T00:00:00+00:00",
+		}, now), "license is inactive")
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		assert.EqualError(t, licenseValidityError(solus.License{
+			IsActive:       true,
+			ExpirationDate: "2021-09-01T00:00:00+00:00",
+		}, now), "license expired at 2021-09-01T00:00:00+00:00")
+	})
+
+	t.Run("invalid expiration date", func(t *testing.T) {
+		err := licenseValidityError(solus.License{
+			IsActive:       true,
+			ExpirationDate: "tomorrow",
+		}, now)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+// notificationTemplates maps notification types to their templates in the
+// settings.
+var notificationTemplates = map[string]func(solus.SettingsNotifications) solus.SettingsNotificationsTemplate{
+	"server_create": func(n solus.SettingsNotifications) solus.SettingsNotificationsTemplate {
+		return n.ServerCreate
+	},
+	"server_reset_password": func(n solus.SettingsNotifications) solus.SettingsNotificationsTemplate {
+		return n.ServerResetPassword
+	},
+	"user_reset_password": func(n solus.SettingsNotifications) solus.SettingsNotificationsTemplate {
+		return n.UserResetPassword
+	},
+	"user_verify_email": func(n solus.SettingsNotifications) solus.SettingsNotificationsTemplate {
+		return n.UserVerifyEmail
+	},
+	"project_user_invite": func(n solus.SettingsNotifications) solus.SettingsNotificationsTemplate {
+		return n.ProjectUserInvite
+	},
+	"project_user_left": func(n solus.SettingsNotifications) solus.SettingsNotificationsTemplate {
+		return n.ProjectUserLeft
+	},
+	"server_incoming_traffic_exceeded": func(n solus.SettingsNotifications) solus.SettingsNotificationsTemplate {
+		return n.ServerIncomingTrafficExceeded
+	},
+	"server_outgoing_traffic_exceeded": func(n solus.SettingsNotifications) solus.SettingsNotificationsTemplate {
+		return n.ServerOutgoingTrafficExceeded
+	},
+}
+
+// resourceNotificationTemplate manages templates of a single notification type.
+// Every type always has a template, so the last applied one is kept when the
+// resource is destroyed.
+func resourceNotificationTemplate() *schema.Resource {
+	types := make([]string, 0, len(notificationTemplates))
+	for t := range notificationTemplates {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	rLocale := regexp.MustCompile(`^[a-z]{2}_[A-Z]{2}$`)
+
+	return &schema.Resource{
+		CreateContext: adoptCreate("Notification Template", resourceNotificationTemplateCreate),
+		ReadContext:   adoptRead("Notification Template", resourceNotificationTemplateRead),
+		UpdateContext: adoptUpdate("Notification Template", resourceNotificationTemplateUpdate),
+		DeleteContext: adoptDelete("Notification Template", resourceNotificationTemplateDelete),
+
+		// Notification template can be imported by its type.
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(types, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"subject": {
+				Type:             schema.TypeMap,
+				Required:         true,
+				Description:      "Subject templates by locale, e.g. en_US",
+				ValidateDiagFunc: validation.MapKeyMatch(rLocale, "should be a locale, e.g. en_US"),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"body": {
+				Type:             schema.TypeMap,
+				Required:         true,
+				Description:      "Body templates by locale, e.g. en_US",
+				ValidateDiagFunc: validation.MapKeyMatch(rLocale, "should be a locale, e.g. en_US"),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceNotificationTemplateCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	res, err := client.Settings.Get(ctx)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	// There is no previous state on creation, so the current templates are
+	// taken from the API to remove locales which aren't configured.
+	t := notificationTemplates[d.Get("type").(string)](res.Notifications)
+	if err := resourceNotificationTemplateApply(ctx, client, d, t.SubjectTemplates, t.BodyTemplated); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("type").(string))
+	return resourceNotificationTemplateRead(ctx, client, d)
+}
+
+func resourceNotificationTemplateRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	get, ok := notificationTemplates[d.Id()]
+	if !ok {
+		return fmt.Errorf("unknown notification type %q", d.Id())
+	}
+
+	res, err := client.Settings.Get(ctx)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	t := get(res.Notifications)
+
+	return newSchemaChainSetter(d).
+		Set("type", d.Id()).
+		Set("enabled", t.Enabled).
+		Set("subject", notificationTemplateFilterEmpty(t.SubjectTemplates)).
+		Set("body", notificationTemplateFilterEmpty(t.BodyTemplated)).
+		Error()
+}
+
+func resourceNotificationTemplateUpdate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	subject, _ := d.GetChange("subject")
+	body, _ := d.GetChange("body")
+
+	if err := resourceNotificationTemplateApply(ctx, client, d, mapOfStrings(subject), mapOfStrings(body)); err != nil {
+		return err
+	}
+	return resourceNotificationTemplateRead(ctx, client, d)
+}
+
+func resourceNotificationTemplateDelete(context.Context, *client, *schema.ResourceData) error {
+	return nil
+}
+
+// resourceNotificationTemplateApply sends the configured templates. Locales of
+// the specified old templates which aren't configured anymore are removed.
+func resourceNotificationTemplateApply(
+	ctx context.Context,
+	client *client,
+	d *schema.ResourceData,
+	oldSubject map[string]string,
+	oldBody map[string]string,
+) error {
+	// Only this template is sent, so templates managed by other resources
+	// won't be changed.
+	_, err := client.SettingsPatch(ctx, settingsUpdateRequest{
+		Notifications: map[string]settingsNotificationsTemplate{
+			d.Get("type").(string): {
+				Enabled:          d.Get("enabled").(bool),
+				SubjectTemplates: notificationTemplateLocales(oldSubject, d.Get("subject")),
+				BodyTemplated:    notificationTemplateLocales(oldBody, d.Get("body")),
+			},
+		},
+	})
+	return normalizeAPIError(err)
+}
+
+// notificationTemplateLocales returns new templates by locale. The API patches
+// templates by locale, so removed locales are sent as empty templates,
+// otherwise their old templates will be kept.
+func notificationTemplateLocales(o map[string]string, n interface{}) map[string]string {
+	res := mapOfStrings(n)
+	if res == nil {
+		res = map[string]string{}
+	}
+
+	for l := range o {
+		if _, ok := res[l]; !ok {
+			res[l] = ""
+		}
+	}
+	return res
+}
+
+// notificationTemplateFilterEmpty removes empty templates, since they are
+// treated as removed ones.
+func notificationTemplateFilterEmpty(m map[string]string) map[string]string {
+	res := make(map[string]string, len(m))
+	for l, t := range m {
+		if t != "" {
+			res[l] = t
+		}
+	}
+	return res
+}
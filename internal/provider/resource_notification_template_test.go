@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/solusio/solus-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccResourceNotificationTemplate(t *testing.T) {
+	resName := "solus_notification_template.project_user_left"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			// Create resource.
+			{
+				Config: `
+resource "solus_notification_template" "project_user_left" {
+	type = "project_user_left"
+	subject = {
+		en_US = "User left the project"
+		de_DE = "Benutzer hat das Projekt verlassen"
+	}
+	body = {
+		en_US = <<EOT
+<p>User {{ user.email }} left the project {{ project.name }}.</p>
+EOT
+		de_DE = "<p>{{ user.email }} hat {{ project.name }} verlassen.</p>"
+	}
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "project_user_left"),
+					resource.TestCheckResourceAttr(resName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "subject.%", "2"),
+					resource.TestCheckResourceAttr(resName, "subject.en_US", "User left the project"),
+					resource.TestCheckResourceAttr(resName, "subject.de_DE", "Benutzer hat das Projekt verlassen"),
+					resource.TestCheckResourceAttr(
+						resName,
+						"body.en_US",
+						"<p>User {{ user.email }} left the project {{ project.name }}.</p>\n",
+					),
+				),
+			},
+
+			// Update resource and remove de_DE locale.
+			{
+				Config: `
+resource "solus_notification_template" "project_user_left" {
+	type = "project_user_left"
+	enabled = false
+	subject = {
+		en_US = "Project member left"
+	}
+	body = {
+		en_US = "<p>{{ user.email }} left {{ project.name }}.</p>"
+	}
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "project_user_left"),
+					resource.TestCheckResourceAttr(resName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resName, "subject.%", "1"),
+					resource.TestCheckResourceAttr(resName, "subject.en_US", "Project member left"),
+					resource.TestCheckResourceAttr(resName, "body.%", "1"),
+					resource.TestCheckResourceAttr(resName, "body.en_US", "<p>{{ user.email }} left {{ project.name }}.</p>"),
+				),
+			},
+
+			// Import resource.
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func Test_notificationTemplates(t *testing.T) {
+	// All notifications from the SDK should be supported.
+	typ := reflect.TypeOf(solus.SettingsNotifications{})
+	for i := 0; i < typ.NumField(); i++ {
+		name := strings.Split(typ.Field(i).Tag.Get("json"), ",")[0]
+		assert.Contains(t, notificationTemplates, name)
+	}
+	assert.Len(t, notificationTemplates, typ.NumField())
+}
+
+func Test_notificationTemplateLocales(t *testing.T) {
+	actual := notificationTemplateLocales(
+		map[string]string{"en_US": "foo", "de_DE": "bar"},
+		map[string]interface{}{"en_US": "baz"},
+	)
+	assert.Equal(t, map[string]string{"en_US": "baz", "de_DE": ""}, actual)
+
+	assert.Equal(t, map[string]string{"en_US": "foo"}, notificationTemplateLocales(
+		nil,
+		map[string]interface{}{"en_US": "foo"},
+	))
+}
+
+func Test_notificationTemplateFilterEmpty(t *testing.T) {
+	actual := notificationTemplateFilterEmpty(map[string]string{"en_US": "foo", "de_DE": ""})
+	assert.Equal(t, map[string]string{"en_US": "foo"}, actual)
+}
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,18 +34,9 @@ func resourceOSImageVersion() *schema.Resource {
 				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
 			"cloud_init_version": {
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
-					v, ok := i.(string)
-					if !ok {
-						return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
-					}
-					if !solus.IsValidCloudInitVersion(v) {
-						return nil, []error{fmt.Errorf("unknown cloud init version %q", v)}
-					}
-					return nil, nil
-				},
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validationIsCloudInitVersion,
 			},
 			"virtualization_type": {
 				Type:         schema.TypeString,
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceReverseDNSRecord manages PTR record of an IP address. There is only
+// one record per IP address, so the IP address ID is used as an ID.
+func resourceReverseDNSRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("Reverse DNS Record", resourceReverseDNSRecordCreate),
+		ReadContext:   adoptRead("Reverse DNS Record", resourceReverseDNSRecordRead),
+		UpdateContext: adoptUpdate("Reverse DNS Record", resourceReverseDNSRecordUpdate),
+		DeleteContext: adoptDelete("Reverse DNS Record", resourceReverseDNSRecordDelete),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip_address_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"domain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validationIsDomainName,
+			},
+
+			"ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceReverseDNSRecordCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id := d.Get("ip_address_id").(int)
+
+	if err := resourceReverseDNSRecordSet(ctx, client, d, id); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(id))
+	return resourceReverseDNSRecordRead(ctx, client, d)
+}
+
+func resourceReverseDNSRecordRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := client.IPAddressGet(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	// Record may be removed outside of Terraform, so it will be created again.
+	if res.ReverseDNS == nil {
+		tflog.Warn(ctx, "IP Address doesn't have Reverse DNS Record, it's removed from the state", "ip_address_id", id)
+		d.SetId("")
+		return nil
+	}
+
+	return newSchemaChainSetter(d).
+		Set("ip_address_id", res.ID).
+		Set("domain", res.ReverseDNS.Domain).
+		Set("ip", res.IP).
+		Error()
+}
+
+func resourceReverseDNSRecordUpdate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := resourceReverseDNSRecordSet(ctx, client, d, id); err != nil {
+		return err
+	}
+	return resourceReverseDNSRecordRead(ctx, client, d)
+}
+
+func resourceReverseDNSRecordDelete(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return normalizeAPIError(client.ReverseDNSRecordDelete(ctx, id))
+}
+
+func resourceReverseDNSRecordSet(ctx context.Context, client *client, d *schema.ResourceData, id int) error {
+	task, err := client.ReverseDNSRecordSet(ctx, id, d.Get("domain").(string))
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	tflog.Trace(ctx, "Wait for Reverse DNS Record will be registered", "ip_address_id", id)
+	if err := waitForTask(ctx, client, task.ID); err != nil {
+		return fmt.Errorf("failed to register reverse DNS record: %w", err)
+	}
+	return nil
+}
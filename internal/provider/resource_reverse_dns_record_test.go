@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceReverseDNSRecord(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_reverse_dns_record." + name
+
+	config := func(domain string) string {
+		return fmt.Sprintf(`
+resource "solus_ip_block" "%[1]s" {
+	name = "%[1]s"
+	ns1 = "192.0.2.1"
+	ns2 = "192.0.2.2"
+	gateway = "192.0.2.3"
+	type = "IPv4"
+	netmask = "255.255.255.0"
+	from = "192.0.2.10"
+	to = "192.0.2.20"
+	reverse_dns {
+		zone = "2.0.192.in-addr.arpa"
+	}
+}
+
+resource "solus_ip_address" "%[1]s" {
+	ip_block_id = solus_ip_block.%[1]s.id
+}
+
+resource "solus_reverse_dns_record" "%[1]s" {
+	ip_address_id = solus_ip_address.%[1]s.id
+	domain = "%[2]s"
+}
+`,
+			name,
+			domain,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIPAddressDestroy,
+		Steps: []resource.TestStep{
+			// Create resource.
+			{
+				Config: config("mail.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "id", "solus_ip_address."+name, "id"),
+					resource.TestCheckResourceAttrPair(resName, "ip", "solus_ip_address."+name, "ip"),
+					resource.TestCheckResourceAttr(resName, "domain", "mail.example.com"),
+				),
+			},
+
+			// Update created resource.
+			{
+				Config: config("smtp.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "domain", "smtp.example.com"),
+				),
+			},
+
+			// Import resource.
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+func resourceRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("Role", resourceRoleCreate),
+		ReadContext:   adoptRead("Role", resourceRoleRead),
+		UpdateContext: adoptUpdate("Role", resourceRoleUpdate),
+		DeleteContext: adoptDelete("Role", resourceRoleDelete),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Names of the permissions granted to the role",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"users_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRoleCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	req, err := buildRoleRequest(ctx, client, d)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Roles.Create(ctx, req)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(strconv.Itoa(res.ID))
+	return resourceRoleRead(ctx, client, d)
+}
+
+func resourceRoleRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := client.RoleGet(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	permissions := make([]string, 0, len(res.Permissions))
+	for _, p := range res.Permissions {
+		permissions = append(permissions, p.Name)
+	}
+
+	return newSchemaChainSetter(d).
+		SetID(res.ID).
+		Set("name", res.Name).
+		Set("permissions", permissions).
+		Set("is_default", res.IsDefault).
+		Set("users_count", res.UsersCount).
+		Error()
+}
+
+func resourceRoleUpdate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	req, err := buildRoleRequest(ctx, client, d)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.RoleUpdate(ctx, id, roleUpdateRequest{
+		RoleCreateRequest: req,
+		Permissions:       req.Permissions,
+	})
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(strconv.Itoa(res.ID))
+	return resourceRoleRead(ctx, client, d)
+}
+
+func resourceRoleDelete(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return normalizeAPIError(client.RoleDelete(ctx, id))
+}
+
+func buildRoleRequest(ctx context.Context, client *client, d *schema.ResourceData) (solus.RoleCreateRequest, error) {
+	req := solus.RoleCreateRequest{
+		Name:        d.Get("name").(string),
+		Permissions: []int{},
+	}
+
+	v, ok := d.GetOk("permissions")
+	if !ok {
+		return req, nil
+	}
+
+	var names []string
+	for _, n := range v.(*schema.Set).List() {
+		names = append(names, n.(string))
+	}
+
+	permissions, err := listPermissions(ctx, client)
+	if err != nil {
+		return solus.RoleCreateRequest{}, err
+	}
+
+	req.Permissions, err = resolvePermissionIDs(permissions, names)
+	if err != nil {
+		return solus.RoleCreateRequest{}, err
+	}
+	return req, nil
+}
+
+// listPermissions returns all available permissions.
+func listPermissions(ctx context.Context, client *client) ([]solus.Permission, error) {
+	res, err := client.Permission.List(ctx)
+	if err != nil {
+		return nil, normalizeAPIError(err)
+	}
+
+	permissions := res.Data
+	for res.Next(ctx) {
+		permissions = append(permissions, res.Data...)
+	}
+
+	if err := res.Err(); err != nil {
+		return nil, normalizeAPIError(err)
+	}
+	return permissions, nil
+}
+
+// resolvePermissionIDs converts permission names to their IDs. All unknown
+// names are reported in a single error.
+func resolvePermissionIDs(permissions []solus.Permission, names []string) ([]int, error) {
+	byName := make(map[string]int, len(permissions))
+	for _, p := range permissions {
+		byName[p.Name] = p.ID
+	}
+
+	var (
+		ids     = make([]int, 0, len(names))
+		unknown []string
+	)
+	for _, n := range names {
+		id, ok := byName[n]
+		if !ok {
+			unknown = append(unknown, n)
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown permissions: %s", strings.Join(unknown, ", "))
+	}
+	return ids, nil
+}
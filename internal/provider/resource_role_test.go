@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/solusio/solus-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccResourceRole(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_role." + name
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			// Create resource.
+			{
+				Config: fmt.Sprintf(`
+data "solus_permissions" "%[1]s" {}
+
+resource "solus_role" "%[1]s" {
+	name = "%[1]s"
+	permissions = [data.solus_permissions.%[1]s.names[0]]
+}
+`,
+					name,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "name", name),
+					resource.TestCheckResourceAttr(resName, "permissions.#", "1"),
+					resource.TestCheckResourceAttr(resName, "is_default", "false"),
+					resource.TestCheckResourceAttr(resName, "users_count", "0"),
+				),
+			},
+
+			// Update resource.
+			{
+				Config: fmt.Sprintf(`
+data "solus_permissions" "%[1]s" {}
+
+resource "solus_role" "%[1]s" {
+	name = "%[1]s-changed"
+	permissions = slice(data.solus_permissions.%[1]s.names, 0, 2)
+}
+`,
+					name,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "name", name+"-changed"),
+					resource.TestCheckResourceAttr(resName, "permissions.#", "2"),
+				),
+			},
+
+			// Remove all permissions.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_role" "%[1]s" {
+	name = "%[1]s-changed"
+}
+`,
+					name,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "permissions.#", "0"),
+				),
+			},
+
+			// Unknown permission.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_role" "%[1]s" {
+	name = "%[1]s"
+	permissions = ["%[1]s-unknown"]
+}
+`,
+					name,
+				),
+				ExpectError: regexp.MustCompile("unknown permissions: " + name + "-unknown"),
+			},
+		},
+	})
+}
+
+func testAccCheckRoleDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "solus_role" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.RoleGet(context.Background(), id)
+		if err == nil {
+			return fmt.Errorf("role %d still exists", id)
+		}
+
+		if !solus.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Test_resolvePermissionIDs(t *testing.T) {
+	permissions := []solus.Permission{
+		{ID: 1, Name: "manage_users"},
+		{ID: 2, Name: "manage_roles"},
+		{ID: 3, Name: "manage_servers"},
+	}
+
+	t.Run("positive", func(t *testing.T) {
+		ids, err := resolvePermissionIDs(permissions, []string{"manage_servers", "manage_users"})
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 1}, ids)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := resolvePermissionIDs(permissions, []string{"manage_users", "foo", "bar"})
+		assert.EqualError(t, err, "unknown permissions: bar, foo")
+	})
+}
@@ -0,0 +1,418 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+// settingsID is an ID of the settings resource. Settings are global, so there
+// is only one instance of them.
+const settingsID = "settings"
+
+// resourceSettings manages global panel settings. Settings always exist, so
+// destroying the resource keeps their current values.
+func resourceSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("Settings", resourceSettingsCreate),
+		ReadContext:   adoptRead("Settings", resourceSettingsRead),
+		UpdateContext: adoptUpdate("Settings", resourceSettingsUpdate),
+		DeleteContext: adoptDelete("Settings", resourceSettingsDelete),
+
+		// Only specified sections will be changed and checked for drift. Other
+		// sections will keep their current values.
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validationIsDomainName,
+			},
+			"mail": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.Any(
+								validation.IsIPAddress,
+								validationIsDomainName,
+							),
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"from_email": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"from_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encryption": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"registration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Name of the role which will be assigned to registered users",
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"network_rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arp": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"dhcp": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"cloud_init": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"smtp": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"icmp": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"icmp_reply": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"non_existent_vms_remover": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							Description:  "Interval between checks in minutes",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"channel": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"scheduled_days": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+						},
+						"scheduled_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`),
+								"should be in HH:MM format",
+							),
+						},
+					},
+				},
+			},
+			"features": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hide_plan_name": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"hide_user_data": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"hide_plan_section": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"hide_location_section": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"allow_registration": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"allow_password_recovery": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSettingsCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	if err := resourceSettingsApply(ctx, client, d); err != nil {
+		return err
+	}
+
+	d.SetId(settingsID)
+	return resourceSettingsRead(ctx, client, d)
+}
+
+func resourceSettingsRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	res, err := client.Settings.Get(ctx)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	s := newSchemaChainSetter(d)
+
+	if _, ok := d.GetOk("hostname"); ok {
+		s.Set("hostname", res.Hostname)
+	}
+
+	if m, ok := settingsBlock(d, "mail"); ok {
+		// Password isn't returned by the API, so it will be taken from the
+		// current state.
+		s.Set("mail", []interface{}{map[string]interface{}{
+			"host":       res.Mail.Host,
+			"username":   res.Mail.Username,
+			"password":   m["password"],
+			"from_email": res.Mail.FromEmail,
+			"from_name":  res.Mail.FromName,
+			"encryption": res.Mail.Encryption,
+		}})
+	}
+
+	if _, ok := settingsBlock(d, "registration"); ok {
+		s.Set("registration", []interface{}{map[string]interface{}{
+			"role": res.Registration.Role,
+		}})
+	}
+
+	if _, ok := settingsBlock(d, "network_rules"); ok {
+		s.Set("network_rules", []interface{}{map[string]interface{}{
+			"arp":        res.NetworkRules.ARP,
+			"dhcp":       res.NetworkRules.DHCP,
+			"cloud_init": res.NetworkRules.CloudInit,
+			"smtp":       res.NetworkRules.SMTP,
+			"icmp":       res.NetworkRules.ICMP,
+			"icmp_reply": res.NetworkRules.ICMPReply,
+		}})
+	}
+
+	if _, ok := settingsBlock(d, "non_existent_vms_remover"); ok {
+		s.Set("non_existent_vms_remover", []interface{}{map[string]interface{}{
+			"enabled":  res.NonExistentVMSRemover.Enabled,
+			"interval": res.NonExistentVMSRemover.Interval,
+		}})
+	}
+
+	if _, ok := settingsBlock(d, "update"); ok {
+		s.Set("update", []interface{}{map[string]interface{}{
+			"method":         res.Update.Method,
+			"channel":        res.Update.Channel,
+			"scheduled_days": res.Update.ScheduledDays,
+			"scheduled_time": res.Update.ScheduledTime,
+		}})
+	}
+
+	if _, ok := settingsBlock(d, "features"); ok {
+		s.Set("features", []interface{}{map[string]interface{}{
+			"hide_plan_name":          res.Features.HidePlanName,
+			"hide_user_data":          res.Features.HideUserData,
+			"hide_plan_section":       res.Features.HidePlanSection,
+			"hide_location_section":   res.Features.HideLocationSection,
+			"allow_registration":      res.Features.AllowRegistration,
+			"allow_password_recovery": res.Features.AllowPasswordRecovery,
+		}})
+	}
+
+	return s.Error()
+}
+
+func resourceSettingsUpdate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	if err := resourceSettingsApply(ctx, client, d); err != nil {
+		return err
+	}
+	return resourceSettingsRead(ctx, client, d)
+}
+
+func resourceSettingsDelete(context.Context, *client, *schema.ResourceData) error {
+	return nil
+}
+
+func resourceSettingsApply(ctx context.Context, client *client, d *schema.ResourceData) error {
+	_, err := client.SettingsPatch(ctx, buildSettingsRequest(d))
+	return normalizeAPIError(err)
+}
+
+// buildSettingsRequest builds a request which contains only sections specified
+// in the configuration.
+func buildSettingsRequest(d *schema.ResourceData) settingsUpdateRequest {
+	var req settingsUpdateRequest
+
+	if v, ok := d.GetOk("hostname"); ok {
+		hostname := v.(string)
+		req.Hostname = &hostname
+	}
+
+	if m, ok := settingsBlock(d, "mail"); ok {
+		req.Mail = &settingsMail{
+			Host:       m["host"].(string),
+			Username:   m["username"].(string),
+			Password:   m["password"].(string),
+			FromEmail:  m["from_email"].(string),
+			FromName:   m["from_name"].(string),
+			Encryption: m["encryption"].(bool),
+		}
+	}
+
+	if m, ok := settingsBlock(d, "registration"); ok {
+		req.Registration = &solus.SettingsRegistration{
+			Role: m["role"].(string),
+		}
+	}
+
+	if m, ok := settingsBlock(d, "network_rules"); ok {
+		req.NetworkRules = &settingsNetworkRules{
+			ARP:       m["arp"].(bool),
+			DHCP:      m["dhcp"].(bool),
+			CloudInit: m["cloud_init"].(bool),
+			SMTP:      m["smtp"].(bool),
+			ICMP:      m["icmp"].(bool),
+			ICMPReply: m["icmp_reply"].(bool),
+		}
+	}
+
+	if m, ok := settingsBlock(d, "non_existent_vms_remover"); ok {
+		req.NonExistentVMSRemover = &settingsNonExistentVMSRemover{
+			Enabled:  m["enabled"].(bool),
+			Interval: m["interval"].(int),
+		}
+	}
+
+	if m, ok := settingsBlock(d, "update"); ok {
+		// Empty list should be sent to clear scheduled days.
+		days := listOfIDs(m["scheduled_days"])
+		if days == nil {
+			days = []int{}
+		}
+
+		req.Update = &settingsUpdate{
+			SettingsUpdate: solus.SettingsUpdate{
+				Method:        m["method"].(string),
+				Channel:       m["channel"].(string),
+				ScheduledTime: m["scheduled_time"].(string),
+			},
+			ScheduledDays: days,
+		}
+	}
+
+	if m, ok := settingsBlock(d, "features"); ok {
+		req.Features = &solus.SettingsFeatures{
+			HidePlanName:          m["hide_plan_name"].(bool),
+			HideUserData:          m["hide_user_data"].(bool),
+			HidePlanSection:       m["hide_plan_section"].(bool),
+			HideLocationSection:   m["hide_location_section"].(bool),
+			AllowRegistration:     m["allow_registration"].(bool),
+			AllowPasswordRecovery: m["allow_password_recovery"].(bool),
+		}
+	}
+
+	return req
+}
+
+// settingsBlock returns values of the specified single item block and flag is
+// it specified or not.
+func settingsBlock(d *schema.ResourceData, k string) (map[string]interface{}, bool) {
+	v, ok := d.GetOk(k)
+	if !ok {
+		return nil, false
+	}
+
+	vv, ok := v.([]interface{})
+	if !ok || len(vv) == 0 {
+		return nil, false
+	}
+
+	// Block without specified properties is represented by nil.
+	m, ok := vv[0].(map[string]interface{})
+	if !ok {
+		m = map[string]interface{}{}
+	}
+	return m, true
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccResourceSettings(t *testing.T) {
+	resName := "solus_settings.settings"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			// Create resource.
+			{
+				Config: `
+resource "solus_settings" "settings" {
+	features {
+		hide_user_data = true
+		allow_password_recovery = true
+	}
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "settings"),
+					resource.TestCheckResourceAttr(resName, "features.0.hide_user_data", "true"),
+					resource.TestCheckResourceAttr(resName, "features.0.allow_password_recovery", "true"),
+					resource.TestCheckResourceAttr(resName, "features.0.allow_registration", "false"),
+					resource.TestCheckNoResourceAttr(resName, "mail.#"),
+					resource.TestCheckNoResourceAttr(resName, "hostname"),
+				),
+			},
+
+			// Update resource.
+			{
+				Config: `
+resource "solus_settings" "settings" {
+	features {
+		allow_password_recovery = true
+	}
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "settings"),
+					resource.TestCheckResourceAttr(resName, "features.0.hide_user_data", "false"),
+					resource.TestCheckResourceAttr(resName, "features.0.allow_password_recovery", "true"),
+				),
+			},
+		},
+	})
+}
+
+func Test_buildSettingsRequest(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSettings().Schema, map[string]interface{}{
+		"hostname": "solus.example.com",
+		"mail": []interface{}{
+			map[string]interface{}{
+				"host":       "smtp.example.com",
+				"password":   "secret",
+				"from_email": "noreply@example.com",
+			},
+		},
+		"network_rules": []interface{}{
+			map[string]interface{}{
+				"arp": true,
+			},
+		},
+		"update": []interface{}{
+			map[string]interface{}{
+				"method":  "auto",
+				"channel": "stable",
+			},
+		},
+	})
+
+	b, err := json.Marshal(buildSettingsRequest(d))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"hostname": "solus.example.com",
+		"mail": {
+			"host": "smtp.example.com",
+			"password": "secret",
+			"from_email": "noreply@example.com",
+			"encryption": false
+		},
+		"network_rules": {
+			"arp": true,
+			"dhcp": false,
+			"cloud_init": false,
+			"smtp": false,
+			"icmp": false,
+			"icmp_reply": false
+		},
+		"update": {
+			"method": "auto",
+			"channel": "stable",
+			"scheduled_days": []
+		}
+	}`, string(b))
+}
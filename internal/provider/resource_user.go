@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+const userGeneratedPasswordLength = 24
+
+func resourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("User", resourceUserCreate),
+		ReadContext:   adoptRead("User", resourceUserRead),
+		UpdateContext: adoptUpdate("User", resourceUserUpdate),
+		DeleteContext: adoptDelete("User", resourceUserDelete),
+
+		// User can be imported by ID or email.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				Description:  "User password, will be generated if not specified",
+				ValidateFunc: validation.StringLenBetween(8, 255),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(solus.UserStatusActive),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(solus.UserStatusActive),
+					string(solus.UserStatusLocked),
+					string(solus.UserStatusSuspended),
+				}, false),
+			},
+			"language_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUserCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	password := d.Get("password").(string)
+	if password == "" {
+		var err error
+		password, err = generatePassword(userGeneratedPasswordLength)
+		if err != nil {
+			return err
+		}
+	}
+
+	res, err := client.Users.Create(ctx, solus.UserCreateRequest{
+		Password:   password,
+		Email:      d.Get("email").(string),
+		Status:     d.Get("status").(string),
+		LanguageID: d.Get("language_id").(int),
+		Roles:      setOfIDs(d.Get("roles")),
+	})
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(strconv.Itoa(res.ID))
+	if err := d.Set("password", password); err != nil {
+		return err
+	}
+	return resourceUserRead(ctx, client, d)
+}
+
+func resourceUserRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := client.UserGet(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	roles := make([]int, 0, len(res.Roles))
+	for _, r := range res.Roles {
+		roles = append(roles, r.ID)
+	}
+
+	var languageID int
+	if res.Language != nil {
+		languageID = res.Language.ID
+	}
+
+	return newSchemaChainSetter(d).
+		SetID(res.ID).
+		Set("email", res.Email).
+		Set("status", string(res.Status)).
+		Set("language_id", languageID).
+		Set("roles", roles).
+		Set("created_at", res.CreatedAt).
+		Error()
+}
+
+func resourceUserUpdate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	req := userUpdateRequest{
+		UserUpdateRequest: solus.UserUpdateRequest{
+			Status:     d.Get("status").(string),
+			LanguageID: d.Get("language_id").(int),
+		},
+		Roles: setOfIDs(d.Get("roles")),
+	}
+
+	// Send password only if it was changed to avoid unnecessary password
+	// resets.
+	if d.HasChange("password") {
+		req.Password = d.Get("password").(string)
+	}
+
+	res, err := client.UserUpdate(ctx, id, req)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(strconv.Itoa(res.ID))
+	return resourceUserRead(ctx, client, d)
+}
+
+func resourceUserDelete(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return normalizeAPIError(client.Users.Delete(ctx, id))
+}
+
+func resourceUserImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.Atoi(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	u, err := userByEmail(ctx, m.(*client), d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(strconv.Itoa(u.ID))
+	return []*schema.ResourceData{d}, nil
+}
+
+func userByEmail(ctx context.Context, client *client, email string) (solus.User, error) {
+	res, err := client.Users.List(ctx, new(solus.FilterUsers))
+	if err != nil {
+		return solus.User{}, normalizeAPIError(err)
+	}
+
+	for {
+		for _, u := range res.Data {
+			if strings.EqualFold(u.Email, email) {
+				return u, nil
+			}
+		}
+
+		if !res.Next(ctx) {
+			break
+		}
+	}
+
+	if err := res.Err(); err != nil {
+		return solus.User{}, normalizeAPIError(err)
+	}
+	return solus.User{}, errResourceNotFound
+}
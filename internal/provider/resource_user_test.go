@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/solusio/solus-go-sdk"
+)
+
+func TestAccResourceUser(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_user." + name
+	email := name + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			// Create resource with generated password.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_user" "%[1]s" {
+	email = "%[2]s"
+}
+`,
+					name,
+					email,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "email", email),
+					resource.TestCheckResourceAttrSet(resName, "password"),
+					resource.TestCheckResourceAttr(resName, "status", string(solus.UserStatusActive)),
+					resource.TestCheckResourceAttrSet(resName, "created_at"),
+				),
+			},
+
+			// Update resource.
+			{
+				Config: fmt.Sprintf(`
+resource "solus_user" "%[1]s" {
+	email = "%[2]s"
+	password = "Sup3r-Secret"
+	status = "locked"
+}
+`,
+					name,
+					email,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "email", email),
+					resource.TestCheckResourceAttr(resName, "password", "Sup3r-Secret"),
+					resource.TestCheckResourceAttr(resName, "status", string(solus.UserStatusLocked)),
+				),
+			},
+
+			// Import by email.
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateId:           email,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccCheckUserDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "solus_user" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.UserGet(context.Background(), id)
+		if err == nil {
+			return fmt.Errorf("user %d still exists", id)
+		}
+
+		if !solus.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -14,6 +15,8 @@ import (
 	"github.com/solusio/solus-go-sdk"
 )
 
+const virtualServerGeneratedPasswordLength = 24
+
 func resourceVirtualServer() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: adoptCreate("Virtual VirtualServer", resourceVirtualServerCreate),
@@ -21,6 +24,8 @@ func resourceVirtualServer() *schema.Resource {
 		UpdateContext: adoptUpdate("Virtual VirtualServer", resourceVirtualServerUpdate),
 		DeleteContext: adoptDelete("Virtual VirtualServer", resourceVirtualServerDelete),
 
+		CustomizeDiff: resourceVirtualServerCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"hostname": {
 				Type:         schema.TypeString,
@@ -32,9 +37,63 @@ func resourceVirtualServer() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
-			"ssh_keys": {
+			"fqdns": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validationIsDomainName,
+				},
+			},
+			"boot_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(solus.BootModeDisk),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(solus.BootModeDisk),
+					string(solus.BootModeRescue),
+				}, false),
+			},
+			"restart_on_boot_mode_change": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Restart started virtual server on boot mode change, so the new boot mode takes effect immediately",
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "Root password, it's changed in place. Generated password is stored here unless `pgp_key` is set",
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"generate_password"},
+			},
+			"generate_password": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Generate a random root password",
+			},
+			"pgp_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Base64 encoded or ASCII armored PGP public key to encrypt the generated password with",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"encrypted_password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Generated password encrypted with `pgp_key` and base64 encoded",
+			},
+			"key_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Fingerprint of `pgp_key`",
+			},
+			"ssh_keys": {
+				Type:     schema.TypeSet,
+				Optional: true,
 				ForceNew: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeInt,
@@ -44,9 +103,34 @@ func resourceVirtualServer() *schema.Resource {
 			"plan_id": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
+				Description:  "Virtual server will be resized on change, or recreated if the new plan is incompatible",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"compute_resource_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Virtual server will be migrated on change",
 				ValidateFunc: validation.IntAtLeast(1),
 			},
+			"live_migration": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Migrate the virtual server without stopping it",
+			},
+			"preserve_ips_on_migration": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Keep the current IP addresses on migration",
+			},
+			"preserve_disk_on_resize": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Keep the current disk size on resize",
+			},
 			"project_id": {
 				Type:         schema.TypeInt,
 				Required:     true,
@@ -97,52 +181,473 @@ func resourceVirtualServer() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"backup_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"schedule_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(solus.ServerBackupSettingsScheduleTypeDaily),
+								string(solus.ServerBackupSettingsScheduleTypeWeekly),
+								string(solus.ServerBackupSettingsScheduleTypeMonthly),
+							}, false),
+						},
+						"hour": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"minute": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+						"days": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Days of week (0-6, Sunday is 0) for weekly or days of month (1-31) for monthly schedule",
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"limit": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							Description:  "Maximum number of backups to keep, 0 means unlimited",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"next_scheduled_backup_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_block_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"primary_ipv4": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"primary_ipv6": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"virtualization_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"specifications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disk": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Disk size in GiB",
+						},
+						"ram": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "RAM size in bytes",
+						},
+						"vcpu": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_suspended": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"user_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"power_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(solus.VirtualServerStatusStarted),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(solus.VirtualServerStatusStarted),
+					string(solus.VirtualServerStatusStopped),
+				}, false),
+			},
+			"restart_triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary map of values which leads to restart of started virtual server when changed",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
 
+func resourceVirtualServerCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client := m.(*client)
+
+	if err := resourceVirtualServerCustomizeDiffPlan(ctx, d, client); err != nil {
+		return err
+	}
+
+	if err := resourceVirtualServerCustomizeDiffComputeResource(ctx, d, client); err != nil {
+		return err
+	}
+
+	if err := resourceVirtualServerCustomizeDiffBackupSettings(ctx, d, client); err != nil {
+		return err
+	}
+	return resourceVirtualServerCustomizeDiffPassword(d)
+}
+
+// resourceVirtualServerCustomizeDiffComputeResource checks the compute
+// resource belongs to the location of the virtual server, otherwise the
+// virtual server would be recreated on the next plan due to location drift.
+func resourceVirtualServerCustomizeDiffComputeResource(
+	ctx context.Context,
+	d *schema.ResourceDiff,
+	client *client,
+) error {
+	if !d.HasChange("compute_resource_id") && !d.HasChange("location_id") {
+		return nil
+	}
+
+	// Compute resource which isn't configured is just carried over from the
+	// state. It belongs to the old location, so it shouldn't be checked
+	// against the new one.
+	raw := d.GetRawConfig()
+	isConfigured := raw.IsKnown() && !raw.IsNull() && !raw.GetAttr("compute_resource_id").IsNull()
+	if !isConfigured && !d.HasChange("compute_resource_id") {
+		return nil
+	}
+
+	if !d.NewValueKnown("compute_resource_id") || !d.NewValueKnown("location_id") {
+		return nil
+	}
+
+	id := d.Get("compute_resource_id").(int)
+	if id == 0 {
+		return nil
+	}
+
+	cr, err := client.ComputeResources.Get(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	locationID := d.Get("location_id").(int)
+	for _, l := range cr.Locations {
+		if l.ID == locationID {
+			return nil
+		}
+	}
+	return fmt.Errorf("compute resource %q doesn't belong to the location %d", cr.Name, locationID)
+}
+
+// resourceVirtualServerCustomizeDiffPlan forces a new virtual server if it
+// can't be resized to the new plan.
+func resourceVirtualServerCustomizeDiffPlan(ctx context.Context, d *schema.ResourceDiff, client *client) error {
+	if d.Id() == "" || !d.HasChange("plan_id") {
+		return nil
+	}
+
+	// Compatibility of unknown plan can't be checked, so we try to resize the
+	// virtual server to avoid unnecessary data loss.
+	if !d.NewValueKnown("plan_id") {
+		return nil
+	}
+
+	oldID, newID := d.GetChange("plan_id")
+
+	from, err := client.Plans.Get(ctx, oldID.(int))
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	to, err := client.Plans.Get(ctx, newID.(int))
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	if !isPlanResizeCompatible(from, to) {
+		return d.ForceNew("plan_id")
+	}
+	return nil
+}
+
+func resourceVirtualServerCustomizeDiffBackupSettings(
+	ctx context.Context,
+	d *schema.ResourceDiff,
+	client *client,
+) error {
+	bs, ok := buildVirtualServerBackupSettings(d.Get("backup_settings"))
+	if !ok || !bs.Enabled {
+		return nil
+	}
+
+	if err := validateBackupScheduleDays(bs.Schedule.Type, bs.Schedule.Days); err != nil {
+		return err
+	}
+
+	if !d.NewValueKnown("plan_id") {
+		return nil
+	}
+
+	p, err := client.Plans.Get(ctx, d.Get("plan_id").(int))
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	if !p.IsBackupAvailable {
+		return fmt.Errorf("backups aren't available for the plan %q", p.Name)
+	}
+	return nil
+}
+
+// resourceVirtualServerCustomizeDiffPassword marks password related
+// properties as changed when a new password will be generated.
+func resourceVirtualServerCustomizeDiffPassword(d *schema.ResourceDiff) error {
+	// Only generated password is encrypted, otherwise the key would be
+	// silently ignored.
+	if d.Get("pgp_key").(string) != "" &&
+		d.NewValueKnown("generate_password") &&
+		!d.Get("generate_password").(bool) {
+		return errors.New("`pgp_key` can be used only with `generate_password = true`")
+	}
+
+	if d.Id() == "" || (!d.HasChange("generate_password") && !d.HasChange("pgp_key")) {
+		return nil
+	}
+
+	if !d.Get("generate_password").(bool) {
+		if err := d.SetNew("encrypted_password", ""); err != nil {
+			return err
+		}
+		return d.SetNew("key_fingerprint", "")
+	}
+
+	for _, k := range []string{"password", "encrypted_password", "key_fingerprint"} {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func resourceVirtualServerCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
-	res, err := client.VirtualServers.Create(ctx, solus.VirtualServerCreateRequest{
-		Name:             d.Get("hostname").(string),
-		Description:      d.Get("description").(string),
-		UserData:         d.Get("user_data").(string),
-		SSHKeys:          listOfIDs(d.Get("ssh_keys")),
-		PlanID:           d.Get("plan_id").(int),
-		ProjectID:        d.Get("project_id").(int),
-		LocationID:       d.Get("location_id").(int),
-		OSImageVersionID: d.Get("os_image_version_id").(int),
-		ApplicationID:    d.Get("application_id").(int),
-		ApplicationData:  d.Get("application_data").(map[string]interface{}),
-	})
+	password, err := resourceVirtualServerPassword(d)
+	if err != nil {
+		return err
+	}
+
+	var res solus.VirtualServer
+	if crID, ok := d.GetOk("compute_resource_id"); ok {
+		res, err = resourceVirtualServerCreateOnComputeResource(ctx, client, d, crID.(int), password)
+	} else {
+		res, err = client.VirtualServers.Create(ctx, solus.VirtualServerCreateRequest{
+			Name:             d.Get("hostname").(string),
+			Description:      d.Get("description").(string),
+			BootMode:         solus.BootMode(d.Get("boot_mode").(string)),
+			FQDNs:            listOfStrings(d.Get("fqdns")),
+			Password:         password,
+			UserData:         d.Get("user_data").(string),
+			SSHKeys:          setOfIDs(d.Get("ssh_keys")),
+			PlanID:           d.Get("plan_id").(int),
+			ProjectID:        d.Get("project_id").(int),
+			LocationID:       d.Get("location_id").(int),
+			OSImageVersionID: d.Get("os_image_version_id").(int),
+			ApplicationID:    d.Get("application_id").(int),
+			ApplicationData:  d.Get("application_data").(map[string]interface{}),
+		})
+	}
 	if err != nil {
 		return normalizeAPIError(err)
 	}
 
-	if err := resourceVirtualServerWaitFor(ctx, client, res.ID); err != nil {
+	// Virtual server is started after creation, so its power state can be
+	// changed only afterwards.
+	if err := resourceVirtualServerWaitFor(ctx, client, res.ID, solus.VirtualServerStatusStarted); err != nil {
 		return err
 	}
 
 	d.SetId(strconv.Itoa(res.ID))
+
+	if err := resourceVirtualServerStorePassword(d, password); err != nil {
+		return err
+	}
+
+	if err := resourceVirtualServerSetPowerState(ctx, client, d, res.ID); err != nil {
+		return err
+	}
+
+	// Backup settings can't be specified on creation, as well as boot mode on
+	// creation on a compute resource.
+	var req virtualServerUpdateRequest
+	if bs, ok := buildVirtualServerBackupSettings(d.Get("backup_settings")); ok {
+		req.BackupSettings = &bs
+	}
+
+	if bootMode := solus.BootMode(d.Get("boot_mode").(string)); res.BootMode != bootMode {
+		req.BootMode = bootMode
+	}
+
+	if req.BackupSettings != nil || req.BootMode != "" {
+		if _, err := client.VirtualServerPatch(ctx, res.ID, req); err != nil {
+			return normalizeAPIError(err)
+		}
+	}
+
 	return resourceVirtualServerRead(ctx, client, d)
 }
 
+// resourceVirtualServerCreateOnComputeResource creates a virtual server on
+// the specified compute resource. The virtual server is owned by the project
+// owner.
+func resourceVirtualServerCreateOnComputeResource(
+	ctx context.Context,
+	client *client,
+	d *schema.ResourceData,
+	computeResourceID int,
+	password string,
+) (solus.VirtualServer, error) {
+	p, err := client.Projects.Get(ctx, d.Get("project_id").(int))
+	if err != nil {
+		return solus.VirtualServer{}, err
+	}
+
+	return client.ComputeResources.ServersCreate(ctx, computeResourceID, solus.ComputeResourceServerCreateRequest{
+		Name:             d.Get("hostname").(string),
+		Description:      d.Get("description").(string),
+		Password:         password,
+		PlanID:           d.Get("plan_id").(int),
+		OSImageVersionID: d.Get("os_image_version_id").(int),
+		ApplicationID:    d.Get("application_id").(int),
+		ApplicationData:  mapOfStrings(d.Get("application_data")),
+		SSHKeys:          setOfIDs(d.Get("ssh_keys")),
+		UserData:         d.Get("user_data").(string),
+		FQDNs:            listOfStrings(d.Get("fqdns")),
+		UserID:           p.Owner.ID,
+		ProjectID:        p.ID,
+	})
+}
+
 func resourceVirtualServerRead(ctx context.Context, client *client, d *schema.ResourceData) error {
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return err
 	}
 
-	res, err := client.VirtualServers.Get(ctx, id)
+	res, err := client.VirtualServerGet(ctx, id)
 	if err != nil {
 		return normalizeAPIError(err)
 	}
 
-	return newSchemaChainSetter(d).
+	var computeResourceID int
+	if res.ComputeResource != nil {
+		computeResourceID = res.ComputeResource.ID
+	}
+
+	s := newSchemaChainSetter(d).
 		SetID(res.ID).
 		Set("hostname", res.Name).
 		Set("description", res.Description).
+		Set("fqdns", res.FQDNs).
+		Set("boot_mode", string(res.BootMode)).
+		Set("plan_id", res.Plan.ID).
+		Set("project_id", res.Project.ID).
+		Set("location_id", res.Location.ID).
+		Set("compute_resource_id", computeResourceID).
 		Set("ips", extractIPAddresses(res.IPs)).
-		Error()
+		Set("ip_addresses", flattenVirtualServerIPAddresses(res.IPs)).
+		Set("primary_ipv4", primaryIPAddress(res.IPs, solus.IPv4)).
+		Set("primary_ipv6", primaryIPAddress(res.IPs, solus.IPv6)).
+		Set("next_scheduled_backup_at", res.NextScheduledBackupAt).
+		Set("uuid", res.UUID).
+		Set("status", string(res.Status)).
+		Set("virtualization_type", string(res.VirtualizationType)).
+		Set("specifications", []interface{}{
+			map[string]interface{}{
+				"disk": res.Specifications.Disk,
+				"ram":  res.Specifications.RAM,
+				"vcpu": res.Specifications.VCPU,
+			},
+		}).
+		Set("created_at", res.CreatedAt).
+		Set("is_suspended", res.IsSuspended).
+		Set("user_id", res.User.ID)
+
+	// SSH keys may be omitted by the API. They can't be changed in place, so
+	// the state is kept in this case to avoid recreation of the server.
+	if len(res.SSHKeys) > 0 {
+		s.Set("ssh_keys", extractSSHKeyIDs(res.SSHKeys))
+	}
+
+	// Disabled backup settings are tracked only if they are specified in the
+	// configuration.
+	if _, ok := d.GetOk("backup_settings"); ok || res.BackupSettings.Enabled {
+		s.Set("backup_settings", flattenVirtualServerBackupSettings(res.BackupSettings))
+	}
+
+	// Transitional statuses aren't reflected in the state, since they can't
+	// be requested.
+	if isVirtualServerPowerState(res.Status) {
+		s.Set("power_state", string(res.Status))
+	} else {
+		tflog.Warn(ctx, "Virtual Server power state isn't tracked", "id", res.ID, "status", res.Status)
+	}
+
+	return s.Error()
 }
 
 func extractIPAddresses(aa []solus.IPBlockIPAddress) (res []string) {
@@ -152,21 +657,102 @@ func extractIPAddresses(aa []solus.IPBlockIPAddress) (res []string) {
 	return
 }
 
+func flattenVirtualServerIPAddresses(aa []solus.IPBlockIPAddress) []interface{} {
+	res := make([]interface{}, 0, len(aa))
+	for _, a := range aa {
+		res = append(res, map[string]interface{}{
+			"id":          a.ID,
+			"ip":          a.IP,
+			"type":        string(a.IPBlock.Type),
+			"ip_block_id": a.IPBlock.ID,
+		})
+	}
+	return res
+}
+
+// primaryIPAddress returns the first IP address of the specified version, or
+// an empty string if there is no such address.
+func primaryIPAddress(aa []solus.IPBlockIPAddress, v solus.IPVersion) string {
+	for _, a := range aa {
+		if a.IPBlock.Type == v {
+			return a.IP
+		}
+	}
+	return ""
+}
+
+func extractSSHKeyIDs(kk []solus.SSHKey) (res []int) {
+	for _, k := range kk {
+		res = append(res, k.ID)
+	}
+	return
+}
+
 func resourceVirtualServerUpdate(ctx context.Context, client *client, d *schema.ResourceData) error {
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return err
 	}
 
-	res, err := client.VirtualServers.Patch(ctx, id, solus.VirtualServerUpdateRequest{
-		Name:        d.Get("hostname").(string),
-		Description: d.Get("description").(string),
-	})
+	if d.HasChange("compute_resource_id") {
+		if err := resourceVirtualServerMigrate(ctx, client, d, id); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("plan_id") {
+		if err := resourceVirtualServerResize(ctx, client, d, id); err != nil {
+			return err
+		}
+	}
+
+	req := virtualServerUpdateRequest{
+		VirtualServerUpdateRequest: solus.VirtualServerUpdateRequest{
+			Name:        d.Get("hostname").(string),
+			Description: d.Get("description").(string),
+			BootMode:    solus.BootMode(d.Get("boot_mode").(string)),
+		},
+	}
+
+	if d.HasChange("fqdns") {
+		// Empty list is sent explicitly to clear FQDNs.
+		fqdns := listOfStrings(d.Get("fqdns"))
+		if fqdns == nil {
+			fqdns = []string{}
+		}
+		req.FQDNs = &fqdns
+	}
+
+	if d.HasChange("backup_settings") {
+		// Removed backup settings means backups should be disabled.
+		bs, _ := buildVirtualServerBackupSettings(d.Get("backup_settings"))
+		req.BackupSettings = &bs
+	}
+
+	res, err := client.VirtualServerPatch(ctx, id, req)
 	if err != nil {
 		return normalizeAPIError(err)
 	}
 
 	d.SetId(strconv.Itoa(res.ID))
+
+	if d.HasChanges("password", "generate_password", "pgp_key") {
+		if err := resourceVirtualServerChangePassword(ctx, client, d, id); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("power_state") {
+		if err := resourceVirtualServerSetPowerState(ctx, client, d, id); err != nil {
+			return err
+		}
+	} else if d.HasChange("restart_triggers") ||
+		(d.HasChange("boot_mode") && d.Get("restart_on_boot_mode_change").(bool)) {
+		if err := resourceVirtualServerRestart(ctx, client, d, id); err != nil {
+			return err
+		}
+	}
+
 	return resourceVirtualServerRead(ctx, client, d)
 }
 
@@ -181,39 +767,356 @@ func resourceVirtualServerDelete(ctx context.Context, client *client, d *schema.
 		return normalizeAPIError(err)
 	}
 
-	return timer.WaitFor(ctx, 5*time.Second, func() (bool, error) {
-		tflog.Trace(ctx, "Wait for Virtual Server %d will be deleted", id)
-		t, err := client.Tasks.Get(ctx, task.ID)
-		if err != nil {
-			return false, normalizeAPIError(err)
-		}
+	tflog.Trace(ctx, "Wait for Virtual Server %d will be deleted", id)
+	if err := waitForTask(ctx, client, task.ID); err != nil {
+		return fmt.Errorf("failed to delete virtual server: %w", err)
+	}
+	return nil
+}
 
-		if !t.IsFinished() {
-			return false, nil
-		}
+func resourceVirtualServerResize(ctx context.Context, client *client, d *schema.ResourceData, id int) error {
+	req := solus.ViretualServerResizeRequest{
+		PreserveDisk: d.Get("preserve_disk_on_resize").(bool),
+		PlanID:       d.Get("plan_id").(int),
+	}
 
-		if t.Status != solus.TaskStatusDone {
-			return false, fmt.Errorf("failed to delete virtual server: %s", t.Output)
-		}
-		return true, nil
+	// Backup settings from the configuration are applied with the new plan.
+	if bs, ok := buildVirtualServerBackupSettings(d.Get("backup_settings")); ok && bs.Enabled {
+		req.BackupSettings = &bs.VirtualServerBackupSettings
+		req.BackupSettings.Enabled = true
+	}
+
+	task, err := client.VirtualServers.Resize(ctx, id, req)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	tflog.Trace(ctx, "Wait for Virtual Server will be resized", "id", id)
+	if err := waitForTask(ctx, client, task.ID); err != nil {
+		return fmt.Errorf("failed to resize virtual server: %w", err)
+	}
+	return nil
+}
+
+func resourceVirtualServerMigrate(ctx context.Context, client *client, d *schema.ResourceData, id int) error {
+	computeResourceID := d.Get("compute_resource_id").(int)
+	if computeResourceID == 0 {
+		return nil
+	}
+
+	vs, err := client.VirtualServerGet(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	if vs.ComputeResource != nil && vs.ComputeResource.ID == computeResourceID {
+		tflog.Info(
+			ctx,
+			"Virtual Server is already on the Compute Resource",
+			"id", id,
+			"compute_resource_id", computeResourceID,
+		)
+		return nil
+	}
+
+	m, err := client.ServersMigrations.Create(ctx, solus.ServersMigrationRequest{
+		IsLive:                       d.Get("live_migration").(bool),
+		PreserveIPs:                  d.Get("preserve_ips_on_migration").(bool),
+		DestinationComputeResourceID: computeResourceID,
+		Servers:                      []int{id},
 	})
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	tflog.Trace(
+		ctx,
+		"Wait for Virtual Server will be migrated to Compute Resource",
+		"id", id,
+		"compute_resource_id", computeResourceID,
+	)
+	if err := waitForServersMigration(ctx, client, m); err != nil {
+		return fmt.Errorf("failed to migrate virtual server: %w", err)
+	}
+	return nil
+}
+
+// isPlanResizeCompatible checks a virtual server can be resized from one plan
+// to another one without recreation.
+func isPlanResizeCompatible(from, to solus.Plan) bool {
+	return from.VirtualizationType == to.VirtualizationType &&
+		from.Params.Disk <= to.Params.Disk
+}
+
+// resourceVirtualServerChangePassword changes root password of the virtual
+// server to the specified or a newly generated one.
+func resourceVirtualServerChangePassword(ctx context.Context, client *client, d *schema.ResourceData, id int) error {
+	// Password in the state is the current one unless it's changed in the
+	// configuration, e.g. when password generation is turned off, so there is
+	// nothing to send.
+	if !d.Get("generate_password").(bool) && !d.HasChange("password") {
+		return resourceVirtualServerStorePassword(d, "")
+	}
+
+	password, err := resourceVirtualServerPassword(d)
+	if err != nil {
+		return err
+	}
+
+	// Password can't be unset, so the current one is kept.
+	if password == "" {
+		return resourceVirtualServerStorePassword(d, "")
+	}
+
+	task, err := client.VirtualServerChangePassword(ctx, id, password)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	tflog.Trace(ctx, "Wait for Virtual Server password will be changed", "id", id)
+	if err := waitForTask(ctx, client, task.ID); err != nil {
+		return fmt.Errorf("failed to change password of virtual server: %w", err)
+	}
+	return resourceVirtualServerStorePassword(d, password)
+}
+
+// resourceVirtualServerPassword returns password from the configuration or
+// generates a new one.
+func resourceVirtualServerPassword(d *schema.ResourceData) (string, error) {
+	if !d.Get("generate_password").(bool) {
+		return d.Get("password").(string), nil
+	}
+	return generatePassword(virtualServerGeneratedPasswordLength)
+}
+
+// resourceVirtualServerStorePassword stores generated password in the state.
+// Password is stored only encrypted if PGP key is specified.
+func resourceVirtualServerStorePassword(d *schema.ResourceData, password string) error {
+	s := newSchemaChainSetter(d)
+
+	if !d.Get("generate_password").(bool) {
+		return s.
+			Set("encrypted_password", "").
+			Set("key_fingerprint", "").
+			Error()
+	}
+
+	key := d.Get("pgp_key").(string)
+	if key == "" {
+		return s.
+			Set("password", password).
+			Set("encrypted_password", "").
+			Set("key_fingerprint", "").
+			Error()
+	}
+
+	encrypted, fingerprint, err := encryptWithPGPKey(key, password)
+	if err != nil {
+		return err
+	}
+
+	return s.
+		Set("password", "").
+		Set("encrypted_password", encrypted).
+		Set("key_fingerprint", fingerprint).
+		Error()
+}
+
+// resourceVirtualServerSetPowerState starts or stops the virtual server
+// according to the `power_state` property.
+func resourceVirtualServerSetPowerState(ctx context.Context, client *client, d *schema.ResourceData, id int) error {
+	vs, err := client.VirtualServers.Get(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	desired := solus.VirtualServerStatus(d.Get("power_state").(string))
+	if vs.Status == desired {
+		return nil
+	}
+
+	if !isVirtualServerPowerState(vs.Status) {
+		return fmt.Errorf("can't change power state of the virtual server, actual status %q", vs.Status)
+	}
+
+	var task solus.Task
+	if desired == solus.VirtualServerStatusStopped {
+		task, err = client.VirtualServers.Stop(ctx, id)
+	} else {
+		task, err = client.VirtualServers.Start(ctx, id)
+	}
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	if err := waitForTask(ctx, client, task.ID); err != nil {
+		return fmt.Errorf("failed to change power state of virtual server: %w", err)
+	}
+
+	return resourceVirtualServerWaitFor(ctx, client, id, desired)
 }
 
-func resourceVirtualServerWaitFor(ctx context.Context, client *client, id int) error {
+// resourceVirtualServerRestart restarts the virtual server. Stopped virtual
+// server is left as is.
+func resourceVirtualServerRestart(ctx context.Context, client *client, d *schema.ResourceData, id int) error {
+	if d.Get("power_state").(string) != string(solus.VirtualServerStatusStarted) {
+		tflog.Info(ctx, "Virtual Server is stopped and won't be restarted", "id", id)
+		return nil
+	}
+
+	task, err := client.VirtualServers.Restart(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	if err := waitForTask(ctx, client, task.ID); err != nil {
+		return fmt.Errorf("failed to restart virtual server: %w", err)
+	}
+
+	return resourceVirtualServerWaitFor(ctx, client, id, solus.VirtualServerStatusStarted)
+}
+
+// resourceVirtualServerWaitFor waits until the virtual server gets the
+// specified status.
+func resourceVirtualServerWaitFor(
+	ctx context.Context,
+	client *client,
+	id int,
+	status solus.VirtualServerStatus,
+) error {
 	return timer.WaitFor(ctx, 5*time.Second, func() (bool, error) {
-		tflog.Trace(ctx, "Wait for Virtual Server %d will start", id)
+		tflog.Trace(ctx, "Wait for Virtual Server will be in status", "id", id, "status", status)
 		resp, err := client.VirtualServers.Get(ctx, id)
 		if err != nil {
 			return false, normalizeAPIError(err)
 		}
 
-		if resp.IsProcessing {
+		if resp.IsProcessing || resp.Status == solus.VirtualServerStatusProcessing {
 			return false, nil
 		}
 
-		if resp.Status != solus.VirtualServerStatusStarted {
-			return false, fmt.Errorf("virtual server didn't started, actual status %q", resp.Status)
+		switch resp.Status {
+		case status:
+			return true, nil
+
+		case solus.VirtualServerStatusPaused:
+			return false, errors.New("virtual server is paused")
+
+		case solus.VirtualServerStatusUnavailable:
+			return false, errors.New("virtual server is unavailable, check its compute resource")
+
+		default:
+			return false, fmt.Errorf("virtual server isn't %s, actual status %q", status, resp.Status)
 		}
-		return true, nil
 	})
 }
+
+// isVirtualServerPowerState checks the status can be used as `power_state`.
+func isVirtualServerPowerState(s solus.VirtualServerStatus) bool {
+	return s == solus.VirtualServerStatusStarted || s == solus.VirtualServerStatusStopped
+}
+
+// virtualServerPlan returns the actual plan of the specified virtual server.
+func virtualServerPlan(ctx context.Context, client *client, id int) (solus.Plan, error) {
+	vs, err := client.VirtualServers.Get(ctx, id)
+	if err != nil {
+		return solus.Plan{}, normalizeAPIError(err)
+	}
+
+	p, err := client.Plans.Get(ctx, vs.Plan.ID)
+	return p, normalizeAPIError(err)
+}
+
+// buildVirtualServerBackupSettings builds backup settings from the
+// `backup_settings` block. Returns false if the block isn't specified.
+func buildVirtualServerBackupSettings(i interface{}) (virtualServerBackupSettings, bool) {
+	vv, ok := i.([]interface{})
+	if !ok || len(vv) == 0 {
+		return virtualServerBackupSettings{}, false
+	}
+
+	m, ok := vv[0].(map[string]interface{})
+	if !ok {
+		return virtualServerBackupSettings{}, false
+	}
+
+	limit := m["limit"].(int) //nolint:errcheck // We are sure about type.
+
+	return virtualServerBackupSettings{
+		VirtualServerBackupSettings: solus.VirtualServerBackupSettings{
+			Schedule: solus.VirtualServerBackupSettingsSchedule{
+				Type: solus.VirtualServerBackupSettingsScheduleType(m["schedule_type"].(string)),
+				Time: solus.VirtualServerBackupSettingsScheduleTime{
+					Hour:    m["hour"].(int),
+					Minutes: m["minute"].(int),
+				},
+				Days: listOfIDs(m["days"]),
+			},
+			Limit: solus.UnitPlanLimit{
+				IsEnabled: limit > 0,
+				Limit:     limit,
+				Unit:      solus.PlanLimitUnits,
+			},
+		},
+		Enabled: m["enabled"].(bool),
+	}, true
+}
+
+func flattenVirtualServerBackupSettings(bs solus.VirtualServerBackupSettings) []interface{} {
+	days := bs.Schedule.Days
+	if days == nil {
+		days = []int{}
+	}
+
+	limit := 0
+	if bs.Limit.IsEnabled {
+		limit = bs.Limit.Limit
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":       bs.Enabled,
+			"schedule_type": string(bs.Schedule.Type),
+			"hour":          bs.Schedule.Time.Hour,
+			"minute":        bs.Schedule.Time.Minutes,
+			"days":          days,
+			"limit":         limit,
+		},
+	}
+}
+
+// validateBackupScheduleDays checks specified days fit the schedule type.
+func validateBackupScheduleDays(t solus.VirtualServerBackupSettingsScheduleType, days []int) error {
+	var minDay, maxDay int
+
+	switch t {
+	case solus.ServerBackupSettingsScheduleTypeDaily:
+		if len(days) > 0 {
+			return errors.New("days can't be specified for daily backup schedule")
+		}
+		return nil
+
+	case solus.ServerBackupSettingsScheduleTypeWeekly:
+		minDay, maxDay = 0, 6
+
+	case solus.ServerBackupSettingsScheduleTypeMonthly:
+		minDay, maxDay = 1, 31
+
+	default:
+		return fmt.Errorf("unknown backup schedule type %q", t)
+	}
+
+	if len(days) == 0 {
+		return fmt.Errorf("days should be specified for %s backup schedule", t)
+	}
+
+	for _, day := range days {
+		if day < minDay || day > maxDay {
+			return fmt.Errorf(
+				"day %d is out of range for %s backup schedule, it should be between %d and %d",
+				day, t, minDay, maxDay,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/solusio/terraform-provider-solus/internal/timer"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+func resourceVirtualServerBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("Virtual Server Backup", resourceVirtualServerBackupCreate),
+		ReadContext:   adoptRead("Virtual Server Backup", resourceVirtualServerBackupRead),
+		DeleteContext: adoptDelete("Virtual Server Backup", resourceVirtualServerBackupDelete),
+
+		Schema: map[string]*schema.Schema{
+			"virtual_server_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Size of the backup in GiB",
+			},
+			"backup_node_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"disk": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the backed up disk in GiB",
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVirtualServerBackupCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	res, err := client.VirtualServers.Backup(ctx, d.Get("virtual_server_id").(int))
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(strconv.Itoa(res.ID))
+
+	if err := resourceVirtualServerBackupWaitFor(ctx, client, res.ID); err != nil {
+		return err
+	}
+	return resourceVirtualServerBackupRead(ctx, client, d)
+}
+
+func resourceVirtualServerBackupRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Backups.Get(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	return newSchemaChainSetter(d).
+		SetID(res.ID).
+		Set("type", string(res.Type)).
+		Set("status", string(res.Status)).
+		Set("size", float64(res.Size)).
+		Set("backup_node_id", res.BackupNode.ID).
+		Set("disk", res.Disk).
+		Set("created_at", res.CreatedAt).
+		Error()
+}
+
+func resourceVirtualServerBackupDelete(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return normalizeAPIError(client.Backups.Delete(ctx, id))
+}
+
+func resourceVirtualServerBackupWaitFor(ctx context.Context, client *client, id int) error {
+	return timer.WaitFor(ctx, 5*time.Second, func() (bool, error) {
+		res, err := client.Backups.Get(ctx, id)
+		if err != nil {
+			return false, normalizeAPIError(err)
+		}
+
+		tflog.Info(ctx, "Wait for Virtual Server Backup will be created", "id", id, "progress", res.BackupProgress)
+
+		if !res.IsFinished() {
+			return false, nil
+		}
+
+		if res.Status == solus.BackupStatusFailed {
+			return false, fmt.Errorf("backup creation is failed: %s", res.BackupFailReason)
+		}
+		return true, nil
+	})
+}
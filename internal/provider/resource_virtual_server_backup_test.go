@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/solusio/solus-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccResourceVirtualServerBackup(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_virtual_server_backup." + name
+
+	var locationID int
+	if raw := os.Getenv("SOLUS_TEST_LOCATION_ID"); raw != "" {
+		var err error
+		locationID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			assert.NotEmpty(
+				t,
+				os.Getenv("SOLUS_TEST_LOCATION_ID"),
+				`"SOLUS_TEST_LOCATION_ID" environment variable must be set for acceptance tests`,
+			)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVirtualServerBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+	is_backup_available = true
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+}
+
+resource "solus_virtual_server_backup" "%[1]s" {
+	virtual_server_id = solus_virtual_server.%[1]s.id
+}
+`,
+					name,
+					locationID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "status", string(solus.BackupStatusCreated)),
+					resource.TestCheckResourceAttr(resName, "type", string(solus.BackupTypeFull)),
+					resource.TestCheckResourceAttrSet(resName, "size"),
+					resource.TestCheckResourceAttrSet(resName, "backup_node_id"),
+					resource.TestCheckResourceAttrSet(resName, "disk"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVirtualServerBackupDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "solus_virtual_server_backup" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.Backups.Get(context.Background(), id)
+		if err == nil {
+			return fmt.Errorf("backup %d still exists", id)
+		}
+
+		if !solus.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+// resourceVirtualServerRestore is an action resource. Virtual server is
+// restored on creation, so changing of any property leads to a new restore.
+// There is nothing to read or undo afterwards.
+func resourceVirtualServerRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("Virtual Server Restore", resourceVirtualServerRestoreCreate),
+		ReadContext:   adoptRead("Virtual Server Restore", resourceVirtualServerRestoreRead),
+		DeleteContext: adoptDelete("Virtual Server Restore", resourceVirtualServerRestoreDelete),
+
+		Schema: map[string]*schema.Schema{
+			"backup_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				ExactlyOneOf: []string{"backup_id", "snapshot_id"},
+			},
+			"snapshot_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				ExactlyOneOf: []string{"backup_id", "snapshot_id"},
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values which leads to a new restore when changed",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualServerRestoreCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	var (
+		task solus.Task
+		err  error
+	)
+
+	if id, ok := d.GetOk("backup_id"); ok {
+		tflog.Trace(ctx, "Restore Virtual Server from Backup", "backup_id", id)
+		task, err = client.Backups.Restore(ctx, id.(int))
+	} else {
+		id := d.Get("snapshot_id").(int)
+		tflog.Trace(ctx, "Revert Virtual Server to Snapshot", "snapshot_id", id)
+		task, err = client.Snapshots.Revert(ctx, id)
+	}
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	if err := waitForTask(ctx, client, task.ID); err != nil {
+		return err
+	}
+
+	// Restore task is used as an ID, since there is no restore entity.
+	d.SetId(strconv.Itoa(task.ID))
+	return nil
+}
+
+func resourceVirtualServerRestoreRead(context.Context, *client, *schema.ResourceData) error {
+	return nil
+}
+
+func resourceVirtualServerRestoreDelete(context.Context, *client, *schema.ResourceData) error {
+	return nil
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccResourceVirtualServerRestore(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_virtual_server_restore." + name
+
+	var locationID int
+	if raw := os.Getenv("SOLUS_TEST_LOCATION_ID"); raw != "" {
+		var err error
+		locationID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+
+	config := func(trigger string) string {
+		return fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+	is_snapshots_enabled = true
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+}
+
+resource "solus_virtual_server_snapshot" "%[1]s" {
+	virtual_server_id = solus_virtual_server.%[1]s.id
+	name = "%[1]s"
+}
+
+resource "solus_virtual_server_restore" "%[1]s" {
+	snapshot_id = solus_virtual_server_snapshot.%[1]s.id
+	triggers = {
+		trigger = "%[3]s"
+	}
+}
+`,
+			name,
+			locationID,
+			trigger,
+		)
+	}
+
+	var firstID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			assert.NotEmpty(
+				t,
+				os.Getenv("SOLUS_TEST_LOCATION_ID"),
+				`"SOLUS_TEST_LOCATION_ID" environment variable must be set for acceptance tests`,
+			)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVirtualServerSnapshotDestroy,
+		Steps: []resource.TestStep{
+			// Revert to snapshot.
+			{
+				Config: config("first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "triggers.trigger", "first"),
+					func(s *terraform.State) error {
+						firstID = s.RootModule().Resources[resName].Primary.ID
+						return nil
+					},
+				),
+			},
+
+			// Revert again after triggers change.
+			{
+				Config: config("second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "triggers.trigger", "second"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[resName].Primary.ID; id == firstID {
+							return fmt.Errorf("restore wasn't performed again, task ID is still %s", id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/solusio/terraform-provider-solus/internal/timer"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/solusio/solus-go-sdk"
+)
+
+func resourceVirtualServerSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adoptCreate("Virtual Server Snapshot", resourceVirtualServerSnapshotCreate),
+		ReadContext:   adoptRead("Virtual Server Snapshot", resourceVirtualServerSnapshotRead),
+		DeleteContext: adoptDelete("Virtual Server Snapshot", resourceVirtualServerSnapshotDelete),
+
+		CustomizeDiff: resourceVirtualServerSnapshotCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"virtual_server_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"size": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Size of the snapshot in GiB",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVirtualServerSnapshotCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// Check is performed only for new snapshots, and only if the virtual
+	// server already exists.
+	if d.Id() != "" || !d.NewValueKnown("virtual_server_id") {
+		return nil
+	}
+
+	p, err := virtualServerPlan(ctx, m.(*client), d.Get("virtual_server_id").(int))
+	if err != nil {
+		return err
+	}
+
+	if !p.IsSnapshotsEnabled {
+		return fmt.Errorf("snapshots are disabled for the plan %q of the virtual server", p.Name)
+	}
+	return nil
+}
+
+func resourceVirtualServerSnapshotCreate(ctx context.Context, client *client, d *schema.ResourceData) error {
+	res, err := client.VirtualServers.SnapshotsCreate(
+		ctx,
+		d.Get("virtual_server_id").(int),
+		solus.SnapshotRequest{
+			Name: d.Get("name").(string),
+		},
+	)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	d.SetId(strconv.Itoa(res.ID))
+
+	if err := resourceVirtualServerSnapshotWaitFor(ctx, client, res.ID); err != nil {
+		return err
+	}
+	return resourceVirtualServerSnapshotRead(ctx, client, d)
+}
+
+func resourceVirtualServerSnapshotRead(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Snapshots.Get(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	return newSchemaChainSetter(d).
+		SetID(res.ID).
+		Set("name", res.Name).
+		Set("size", res.Size).
+		Set("status", string(res.Status)).
+		Set("created_at", res.CreatedAt).
+		Error()
+}
+
+func resourceVirtualServerSnapshotDelete(ctx context.Context, client *client, d *schema.ResourceData) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	task, err := client.Snapshots.Delete(ctx, id)
+	if err != nil {
+		return normalizeAPIError(err)
+	}
+
+	tflog.Trace(ctx, "Wait for Virtual Server Snapshot will be deleted", "id", id)
+	return waitForTask(ctx, client, task.ID)
+}
+
+func resourceVirtualServerSnapshotWaitFor(ctx context.Context, client *client, id int) error {
+	return timer.WaitFor(ctx, 5*time.Second, func() (bool, error) {
+		tflog.Trace(ctx, "Wait for Virtual Server Snapshot will be available", "id", id)
+		res, err := client.Snapshots.Get(ctx, id)
+		if err != nil {
+			return false, normalizeAPIError(err)
+		}
+
+		switch res.Status {
+		case solus.SnapshotStatusAvailable:
+			return true, nil
+
+		case solus.SnapshotStatusProcessing:
+			return false, nil
+
+		case solus.SnapshotStatusFailed:
+			return false, errors.New("snapshot creation is failed")
+		}
+		return false, fmt.Errorf("unexpected snapshot status %q", res.Status)
+	})
+}
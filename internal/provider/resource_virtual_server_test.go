@@ -5,9 +5,12 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -44,6 +47,19 @@ func TestAccResourceVirtualServer(t *testing.T) {
 			resource.TestCheckResourceAttr(resName, "hostname", hostname),
 			resource.TestCheckResourceAttr(resName, "description", description),
 			resource.TestCheckResourceAttrSet(resName, "ips.0"),
+			resource.TestCheckResourceAttrPair(resName, "ip_addresses.0.ip", resName, "ips.0"),
+			resource.TestCheckResourceAttrSet(resName, "primary_ipv4"),
+			resource.TestCheckResourceAttrPair(resName, "plan_id", "solus_plan."+name, "id"),
+			resource.TestCheckResourceAttrPair(resName, "project_id", "solus_project."+name, "id"),
+			resource.TestCheckTypeSetElemAttrPair(resName, "ssh_keys.*", "solus_ssh_key."+name, "id"),
+			resource.TestCheckResourceAttrSet(resName, "uuid"),
+			resource.TestCheckResourceAttr(resName, "status", "started"),
+			resource.TestCheckResourceAttr(resName, "virtualization_type", "kvm"),
+			resource.TestCheckResourceAttr(resName, "specifications.0.vcpu", "1"),
+			resource.TestCheckResourceAttr(resName, "is_suspended", "false"),
+			resource.TestCheckResourceAttrSet(resName, "user_id"),
+			resource.TestCheckResourceAttrSet(resName, "compute_resource_id"),
+			resource.TestCheckResourceAttrSet(resName, "created_at"),
 		)
 	}
 
@@ -189,3 +205,1281 @@ func generateSSHPublicKey() (string, error) {
 
 	return strings.TrimSpace(pubKeyBuf.String()), nil
 }
+
+func TestAccResourceVirtualServer_backupSettings(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_virtual_server." + name
+
+	var locationID int
+	if raw := os.Getenv("SOLUS_TEST_LOCATION_ID"); raw != "" {
+		var err error
+		locationID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			assert.NotEmpty(
+				t,
+				os.Getenv("SOLUS_TEST_LOCATION_ID"),
+				`"SOLUS_TEST_LOCATION_ID" environment variable must be set for acceptance tests`,
+			)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVirtualServerDestroy,
+		Steps: []resource.TestStep{
+			// Backups aren't available.
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+	is_backup_available = false
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+	backup_settings {
+		schedule_type = "daily"
+	}
+}
+`,
+					name,
+					locationID,
+				),
+				ExpectError: regexp.MustCompile("backups aren't available for the plan"),
+			},
+
+			// Create resource with backup settings.
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+	is_backup_available = true
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+	backup_settings {
+		schedule_type = "weekly"
+		hour = 3
+		minute = 30
+		days = [1, 5]
+		limit = 3
+	}
+}
+`,
+					name,
+					locationID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "backup_settings.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "backup_settings.0.schedule_type", "weekly"),
+					resource.TestCheckResourceAttr(resName, "backup_settings.0.hour", "3"),
+					resource.TestCheckResourceAttr(resName, "backup_settings.0.minute", "30"),
+					resource.TestCheckResourceAttr(resName, "backup_settings.0.days.#", "2"),
+					resource.TestCheckResourceAttr(resName, "backup_settings.0.limit", "3"),
+					resource.TestCheckResourceAttrSet(resName, "next_scheduled_backup_at"),
+				),
+			},
+
+			// Disable backups.
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+	is_backup_available = true
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+}
+`,
+					name,
+					locationID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "backup_settings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func Test_validateBackupScheduleDays(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[solus.VirtualServerBackupSettingsScheduleType][]int{
+			solus.ServerBackupSettingsScheduleTypeDaily:   nil,
+			solus.ServerBackupSettingsScheduleTypeWeekly:  {0, 6},
+			solus.ServerBackupSettingsScheduleTypeMonthly: {1, 31},
+		}
+
+		for typ, days := range cc {
+			t.Run(string(typ), func(t *testing.T) {
+				assert.NoError(t, validateBackupScheduleDays(typ, days))
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := []struct {
+			typ      solus.VirtualServerBackupSettingsScheduleType
+			days     []int
+			expected string
+		}{
+			{
+				typ:      solus.ServerBackupSettingsScheduleTypeDaily,
+				days:     []int{1},
+				expected: "days can't be specified for daily backup schedule",
+			},
+			{
+				typ:      solus.ServerBackupSettingsScheduleTypeWeekly,
+				expected: "days should be specified for weekly backup schedule",
+			},
+			{
+				typ:      solus.ServerBackupSettingsScheduleTypeWeekly,
+				days:     []int{7},
+				expected: "day 7 is out of range for weekly backup schedule, it should be between 0 and 6",
+			},
+			{
+				typ:      solus.ServerBackupSettingsScheduleTypeMonthly,
+				days:     []int{0},
+				expected: "day 0 is out of range for monthly backup schedule, it should be between 1 and 31",
+			},
+			{
+				typ:      "hourly",
+				expected: `unknown backup schedule type "hourly"`,
+			},
+		}
+
+		for _, c := range cc {
+			t.Run(c.expected, func(t *testing.T) {
+				assert.EqualError(t, validateBackupScheduleDays(c.typ, c.days), c.expected)
+			})
+		}
+	})
+}
+
+func Test_buildVirtualServerBackupSettings(t *testing.T) {
+	t.Run("not specified", func(t *testing.T) {
+		_, ok := buildVirtualServerBackupSettings([]interface{}{})
+		assert.False(t, ok)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		bs, ok := buildVirtualServerBackupSettings([]interface{}{
+			map[string]interface{}{
+				"enabled":       false,
+				"schedule_type": "monthly",
+				"hour":          1,
+				"minute":        2,
+				"days":          []interface{}{15},
+				"limit":         0,
+			},
+		})
+		require.True(t, ok)
+
+		b, err := json.Marshal(bs)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"enabled": false,
+			"schedule": {
+				"type": "monthly",
+				"time": {"hour": 1, "minutes": 2},
+				"days": [15]
+			},
+			"limit": {"is_enabled": false, "limit": 0, "unit": "units"}
+		}`, string(b))
+	})
+}
+
+func TestAccResourceVirtualServer_resize(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_virtual_server." + name
+
+	var locationID int
+	if raw := os.Getenv("SOLUS_TEST_LOCATION_ID"); raw != "" {
+		var err error
+		locationID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+
+	config := func(plan string) string {
+		return fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+}
+
+resource "solus_plan" "%[1]s_bigger" {
+	name = "%[1]s-bigger"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 2
+		ram_mb = 2048
+		vcpu = 2
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = %[3]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+}
+`,
+			name,
+			locationID,
+			plan,
+		)
+	}
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			assert.NotEmpty(
+				t,
+				os.Getenv("SOLUS_TEST_LOCATION_ID"),
+				`"SOLUS_TEST_LOCATION_ID" environment variable must be set for acceptance tests`,
+			)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVirtualServerDestroy,
+		Steps: []resource.TestStep{
+			// Create resource.
+			{
+				Config: config("solus_plan." + name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "plan_id", "solus_plan."+name, "id"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources[resName].Primary.ID
+						return nil
+					},
+				),
+			},
+
+			// Resize to the bigger plan in place.
+			{
+				Config: config("solus_plan." + name + "_bigger"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "plan_id", "solus_plan."+name+"_bigger", "id"),
+					resource.TestCheckResourceAttrPtr(resName, "id", &id),
+				),
+			},
+
+			// Smaller plan leads to replacement.
+			{
+				Config: config("solus_plan." + name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "plan_id", "solus_plan."+name, "id"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources[resName].Primary.ID == id {
+							return errors.New("virtual server should be recreated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func Test_isPlanResizeCompatible(t *testing.T) {
+	plan := func(virtualizationType solus.VirtualizationType, disk int) solus.Plan {
+		return solus.Plan{
+			VirtualizationType: virtualizationType,
+			Params:             solus.PlanParams{Disk: disk},
+		}
+	}
+
+	cc := map[string]struct {
+		from     solus.Plan
+		to       solus.Plan
+		expected bool
+	}{
+		"same disk": {
+			from:     plan(solus.VirtualizationTypeKVM, 10),
+			to:       plan(solus.VirtualizationTypeKVM, 10),
+			expected: true,
+		},
+		"bigger disk": {
+			from:     plan(solus.VirtualizationTypeKVM, 10),
+			to:       plan(solus.VirtualizationTypeKVM, 20),
+			expected: true,
+		},
+		"smaller disk": {
+			from:     plan(solus.VirtualizationTypeKVM, 20),
+			to:       plan(solus.VirtualizationTypeKVM, 10),
+			expected: false,
+		},
+		"another virtualization type": {
+			from:     plan(solus.VirtualizationTypeKVM, 10),
+			to:       plan(solus.VirtualizationTypeVZ, 10),
+			expected: false,
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expected, isPlanResizeCompatible(c.from, c.to))
+		})
+	}
+}
+
+func TestAccResourceVirtualServer_powerState(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_virtual_server." + name
+
+	var locationID int
+	if raw := os.Getenv("SOLUS_TEST_LOCATION_ID"); raw != "" {
+		var err error
+		locationID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			assert.NotEmpty(
+				t,
+				os.Getenv("SOLUS_TEST_LOCATION_ID"),
+				`"SOLUS_TEST_LOCATION_ID" environment variable must be set for acceptance tests`,
+			)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVirtualServerDestroy,
+		Steps: []resource.TestStep{
+			// Create stopped resource.
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+	power_state = "stopped"
+}
+`,
+					name,
+					locationID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "power_state", "stopped"),
+				),
+			},
+
+			// Start resource.
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+	power_state = "started"
+}
+`,
+					name,
+					locationID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "power_state", "started"),
+				),
+			},
+
+			// Restart resource.
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+	power_state = "started"
+	restart_triggers = {
+		reason = "test"
+	}
+}
+`,
+					name,
+					locationID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "power_state", "started"),
+					resource.TestCheckResourceAttr(resName, "restart_triggers.reason", "test"),
+				),
+			},
+		},
+	})
+}
+
+func Test_isVirtualServerPowerState(t *testing.T) {
+	cc := map[solus.VirtualServerStatus]bool{
+		solus.VirtualServerStatusStarted:     true,
+		solus.VirtualServerStatusStopped:     true,
+		solus.VirtualServerStatusProcessing:  false,
+		solus.VirtualServerStatusPaused:      false,
+		solus.VirtualServerStatusUnavailable: false,
+		solus.VirtualServerStatusNotExists:   false,
+	}
+
+	for status, expected := range cc {
+		t.Run(string(status), func(t *testing.T) {
+			assert.Equal(t, expected, isVirtualServerPowerState(status))
+		})
+	}
+}
+
+func TestAccResourceVirtualServer_bootModeAndFQDNs(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_virtual_server." + name
+
+	var locationID int
+	if raw := os.Getenv("SOLUS_TEST_LOCATION_ID"); raw != "" {
+		var err error
+		locationID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			assert.NotEmpty(
+				t,
+				os.Getenv("SOLUS_TEST_LOCATION_ID"),
+				`"SOLUS_TEST_LOCATION_ID" environment variable must be set for acceptance tests`,
+			)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVirtualServerDestroy,
+		Steps: []resource.TestStep{
+			// Create resource with FQDNs.
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+	fqdns = ["%[1]s.example.com", "www.%[1]s.example.com"]
+}
+`,
+					name,
+					locationID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "boot_mode", "disk"),
+					resource.TestCheckResourceAttr(resName, "fqdns.#", "2"),
+					resource.TestCheckResourceAttr(resName, "fqdns.1", "www."+name+".example.com"),
+				),
+			},
+
+			// Boot into rescue mode and clear FQDNs.
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+	boot_mode = "rescue"
+	restart_on_boot_mode_change = true
+}
+`,
+					name,
+					locationID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "boot_mode", "rescue"),
+					resource.TestCheckResourceAttr(resName, "power_state", "started"),
+					resource.TestCheckResourceAttr(resName, "fqdns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceVirtualServer_password(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_virtual_server." + name
+
+	var locationID int
+	if raw := os.Getenv("SOLUS_TEST_LOCATION_ID"); raw != "" {
+		var err error
+		locationID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			assert.NotEmpty(
+				t,
+				os.Getenv("SOLUS_TEST_LOCATION_ID"),
+				`"SOLUS_TEST_LOCATION_ID" environment variable must be set for acceptance tests`,
+			)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVirtualServerDestroy,
+		Steps: []resource.TestStep{
+			// PGP key without password generation.
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+	password = "Sup3r-Secret"
+	pgp_key = "foo"
+}
+`,
+					name,
+					locationID,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`pgp_key` can be used only with `generate_password = true`"),
+			},
+
+			// Create resource with generated password.
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+	generate_password = true
+}
+`,
+					name,
+					locationID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "password"),
+					resource.TestCheckResourceAttr(resName, "encrypted_password", ""),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources[resName].Primary.ID
+						return nil
+					},
+				),
+			},
+
+			// Change password in place.
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+	password = "Sup3r-Secret"
+}
+`,
+					name,
+					locationID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "password", "Sup3r-Secret"),
+					resource.TestCheckResourceAttrPtr(resName, "id", &id),
+				),
+			},
+		},
+	})
+}
+
+func Test_flattenVirtualServerIPAddresses(t *testing.T) {
+	actual := flattenVirtualServerIPAddresses([]solus.IPBlockIPAddress{
+		{
+			ID:      1,
+			IP:      "192.0.2.1",
+			IPBlock: solus.IPBlock{ID: 10, Type: solus.IPv4},
+		},
+		{
+			ID:      2,
+			IP:      "2001:db8::1",
+			IPBlock: solus.IPBlock{ID: 20, Type: solus.IPv6},
+		},
+	})
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"id":          1,
+			"ip":          "192.0.2.1",
+			"type":        "IPv4",
+			"ip_block_id": 10,
+		},
+		map[string]interface{}{
+			"id":          2,
+			"ip":          "2001:db8::1",
+			"type":        "IPv6",
+			"ip_block_id": 20,
+		},
+	}, actual)
+}
+
+func Test_primaryIPAddress(t *testing.T) {
+	aa := []solus.IPBlockIPAddress{
+		{IP: "2001:db8::1", IPBlock: solus.IPBlock{Type: solus.IPv6}},
+		{IP: "192.0.2.1", IPBlock: solus.IPBlock{Type: solus.IPv4}},
+		{IP: "192.0.2.2", IPBlock: solus.IPBlock{Type: solus.IPv4}},
+	}
+
+	assert.Equal(t, "192.0.2.1", primaryIPAddress(aa, solus.IPv4))
+	assert.Equal(t, "2001:db8::1", primaryIPAddress(aa, solus.IPv6))
+	assert.Equal(t, "", primaryIPAddress(aa[1:], solus.IPv6))
+}
+
+func TestAccResourceVirtualServer_migration(t *testing.T) {
+	name := generateResourceName()
+	resName := "solus_virtual_server." + name
+
+	var locationID int
+	if raw := os.Getenv("SOLUS_TEST_LOCATION_ID"); raw != "" {
+		var err error
+		locationID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+
+	var sourceID, destinationID int
+	if raw := os.Getenv("SOLUS_TEST_COMPUTE_RESOURCE_ID"); raw != "" {
+		var err error
+		sourceID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+	if raw := os.Getenv("SOLUS_TEST_DESTINATION_COMPUTE_RESOURCE_ID"); raw != "" {
+		var err error
+		destinationID, err = strconv.Atoi(raw)
+		require.NoError(t, err)
+	}
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			ee := []string{
+				"SOLUS_TEST_LOCATION_ID",
+				"SOLUS_TEST_COMPUTE_RESOURCE_ID",
+				"SOLUS_TEST_DESTINATION_COMPUTE_RESOURCE_ID",
+			}
+			for _, e := range ee {
+				assert.NotEmptyf(t, os.Getenv(e), "%q environment variable must be set for acceptance tests", e)
+			}
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVirtualServerDestroy,
+		Steps: []resource.TestStep{
+			// Create resource on the specified compute resource.
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+	compute_resource_id = %[3]d
+}
+`,
+					name,
+					locationID,
+					sourceID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "compute_resource_id", strconv.Itoa(sourceID)),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources[resName].Primary.ID
+						return nil
+					},
+				),
+			},
+
+			// Migrate resource in place.
+			{
+				Config: fmt.Sprintf(`
+data "solus_location" "%[1]s" {
+	id = %[2]d
+}
+
+resource "solus_project" "%[1]s" {
+	name = "%[1]s"
+}
+
+resource "solus_os_image" "%[1]s" {
+	name = "Alpine %[1]s"
+}
+
+resource "solus_os_image_version" "%[1]s" {
+	os_image_id = solus_os_image.%[1]s.id
+	version = "%[1]s"
+	url = "https://images.prod.solus.io/solus-alpine-3.15.qcow2"
+	cloud_init_version = "v2"
+	virtualization_type = "kvm"
+}
+
+resource "solus_plan" "%[1]s" {
+	name = "%[1]s"
+	virtualization_type = "kvm"
+	storage_type = "fb"
+	image_format = "qcow2"
+	params {
+		disk = 1
+		ram_mb = 1024
+		vcpu = 1
+	}
+	available_locations = [
+		data.solus_location.%[1]s.id
+	]
+	available_os_image_versions = [
+		solus_os_image_version.%[1]s.id
+	]
+}
+
+resource "solus_virtual_server" "%[1]s" {
+	hostname = "%[1]s.example.com"
+	location_id = data.solus_location.%[1]s.id
+	os_image_version_id = solus_os_image_version.%[1]s.id
+	plan_id = solus_plan.%[1]s.id
+	project_id = solus_project.%[1]s.id
+	user_data = "#cloud-config"
+	compute_resource_id = %[3]d
+	live_migration = true
+}
+`,
+					name,
+					locationID,
+					destinationID,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "compute_resource_id", strconv.Itoa(destinationID)),
+					resource.TestCheckResourceAttr(resName, "power_state", "started"),
+					resource.TestCheckResourceAttrPtr(resName, "id", &id),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/solusio/terraform-provider-solus/internal/timer"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/solusio/solus-go-sdk"
+)
+
+// waitForTask waits until the specified task is finished. An error with the
+// task output is returned if the task isn't finished successfully.
+func waitForTask(ctx context.Context, client *client, id int) error {
+	t, err := waitForTaskFinished(ctx, client, id)
+	if err != nil {
+		return err
+	}
+	return taskError(t)
+}
+
+// waitForTaskFinished waits until the specified task is finished, successfully
+// or not, and returns the finished task.
+func waitForTaskFinished(ctx context.Context, client *client, id int) (solus.Task, error) {
+	var t solus.Task
+	err := timer.WaitFor(ctx, 5*time.Second, func() (bool, error) {
+		var err error
+		t, err = client.Tasks.Get(ctx, id)
+		if err != nil {
+			return false, normalizeAPIError(err)
+		}
+
+		tflog.Trace(ctx, "Wait for Task will be finished", "id", t.ID, "action", t.Action, "progress", t.Progress)
+		return t.IsFinished(), nil
+	})
+	return t, err
+}
+
+// waitForServersMigration waits until all servers of the migration are
+// migrated, and then until the migration itself is finished.
+func waitForServersMigration(ctx context.Context, client *client, m solus.ServersMigration) error {
+	for _, c := range m.Children {
+		if err := waitForTask(ctx, client, c.ID); err != nil {
+			return err
+		}
+	}
+	return waitForTask(ctx, client, m.Task.ID)
+}
+
+// taskError returns an error if the specified task isn't finished
+// successfully.
+func taskError(t solus.Task) error {
+	if t.Status == solus.TaskStatusDone {
+		return nil
+	}
+	return fmt.Errorf("task %q is %s: %s", t.Action, t.Status, t.Output)
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/solusio/solus-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_taskError(t *testing.T) {
+	t.Run("done", func(t *testing.T) {
+		assert.NoError(t, taskError(solus.Task{Status: solus.TaskStatusDone}))
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		err := taskError(solus.Task{
+			Action: solus.TaskActionSnapshotDelete,
+			Status: solus.TaskStatusFailed,
+			Output: "snapshot is locked",
+		})
+		assert.EqualError(t, err, `task "snapshot-delete" is failed: snapshot is locked`)
+	})
+}
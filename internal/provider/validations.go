@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/solusio/solus-go-sdk"
 )
 
@@ -47,3 +48,48 @@ func validationIsVirtualizationType(i interface{}, k string) ([]string, []error)
 	}
 	return nil, nil
 }
+
+func validationIsCloudInitVersion(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if !solus.IsValidCloudInitVersion(v) {
+		return nil, []error{fmt.Errorf("unknown cloud init version %q", v)}
+	}
+	return nil, nil
+}
+
+// validationIsTemplate checks that specified value contains only known
+// placeholders. Placeholder looks like `{{ name }}`.
+func validationIsTemplate(placeholders ...string) schema.SchemaValidateFunc {
+	known := make(map[string]struct{}, len(placeholders))
+	for _, p := range placeholders {
+		known[p] = struct{}{}
+	}
+
+	return func(i interface{}, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+		}
+
+		var ee []error
+		for _, m := range rTemplatePlaceholder.FindAllStringSubmatch(v, -1) {
+			if _, ok := known[m[1]]; !ok {
+				ee = append(ee, fmt.Errorf(
+					"unknown placeholder %q in %q, supported placeholders are: %s",
+					m[1], k, strings.Join(placeholders, ", "),
+				))
+			}
+		}
+
+		// Remaining braces mean the placeholder is malformed.
+		if strings.ContainsAny(rTemplatePlaceholder.ReplaceAllString(v, ""), "{}") {
+			ee = append(ee, fmt.Errorf("malformed placeholder in %q", k))
+		}
+		return nil, ee
+	}
+}
+
+var rTemplatePlaceholder = regexp.MustCompile(`{{\s*([a-z-]*)\s*}}`)
@@ -101,3 +101,40 @@ func Test_validationIsVirtualizationType(t *testing.T) {
 		}
 	})
 }
+
+func Test_validationIsTemplate(t *testing.T) {
+	fn := validationIsTemplate("ip-dashed", "hostname")
+
+	t.Run("positive", func(t *testing.T) {
+		cc := []string{
+			"example.com",
+			"{{ip-dashed}}.example.com",
+			"{{ ip-dashed }}.{{hostname}}",
+		}
+
+		for _, c := range cc {
+			t.Run(c, func(t *testing.T) {
+				ww, ee := fn(c, "foo")
+				assert.Nil(t, ww)
+				assert.Nil(t, ee)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]interface{}{
+			`expected type of "foo" to be string`:                                                 42,
+			`unknown placeholder "bar" in "foo", supported placeholders are: ip-dashed, hostname`: "{{bar}}.example.com",
+			`malformed placeholder in "foo"`:                                                      "{{ip-dashed}.example.com",
+		}
+
+		for expected, val := range cc {
+			t.Run(expected, func(t *testing.T) {
+				ww, ee := fn(val, "foo")
+				assert.Nil(t, ww)
+				require.Len(t, ee, 1)
+				assert.EqualError(t, ee[0], expected)
+			})
+		}
+	})
+}